@@ -14,13 +14,55 @@ const (
 	StatusWarn Status = "warn"
 )
 
+// Severity orders the statuses from healthy to unhealthy: pass is 0, warn is 1 and fail,
+// or any unknown status, is 2.
+func (s Status) Severity() int {
+	switch s {
+	case StatusPass:
+		return 0
+	case StatusWarn:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Component types for the componentType field of a Result, as defined by the RFC.
+// Any other value describing the type of the component is also valid.
+const (
+	ComponentTypeComponent = "component"
+	ComponentTypeDatastore = "datastore"
+	ComponentTypeSystem    = "system"
+)
+
+// Units for the observedUnit field of a Result.
+const (
+	UnitMilliseconds = "ms"
+	UnitPercent      = "%"
+)
+
 // Result represents the result of an individual health check execution.
+// The fields follow the check object of https://inadarei.github.io/rfc-healthcheck/.
 type Result struct {
-	Status        Status    `json:"status"`
-	Output        string    `json:"output,omitempty"`
-	Time          time.Time `json:"time"`
-	ObservedValue any       `json:"observed_value,omitempty"`
-	ObservedUnit  string    `json:"observed_unit,omitempty"`
+	ComponentID       string            `json:"componentId,omitempty"`
+	ComponentType     string            `json:"componentType,omitempty"`
+	Status            Status            `json:"status"`
+	Output            string            `json:"output,omitempty"`
+	Time              time.Time         `json:"time"`
+	ObservedValue     any               `json:"observedValue,omitempty"`
+	ObservedUnit      string            `json:"observedUnit,omitempty"`
+	AffectedEndpoints []string          `json:"affectedEndpoints,omitempty"`
+	Links             map[string]string `json:"links,omitempty"`
+
+	// Attempts is the number of times the check was attempted, when it is run with retries.
+	Attempts int `json:"attempts,omitempty"`
+
+	// Checks holds the nested results of the components summarized by the check, keyed by component name.
+	Checks map[string][]Result `json:"checks,omitempty"`
+
+	// Duration is the time the check took to run. It is measured by the HealthCheck when not set by the check,
+	// and is not part of the JSON representation.
+	Duration time.Duration `json:"-"`
 }
 
 // Check is an interface that any health check implementation must satisfy.
@@ -31,3 +73,17 @@ type Check interface {
 	GetName() string                // Returns the unique name for this specific check (e.g., "db-check:open-connections")
 	Run(ctx context.Context) Result // Returns a single result for this specific check
 }
+
+// Validator is implemented by checks that can verify their configuration without running.
+// Validate returns an error describing every invalid setting, or nil if the configuration is valid.
+type Validator interface {
+	Validate() error
+}
+
+// Validate verifies the configuration of the check, if it implements Validator.
+func Validate(check Check) error {
+	if v, ok := check.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
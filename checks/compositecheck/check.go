@@ -0,0 +1,229 @@
+// Package compositecheck provides checks that combine the results of several child checks
+// with all-of, any-of or k-of-n (quorum) logic.
+package compositecheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// Mode defines how the results of the child checks are combined.
+type Mode string
+
+const (
+	// ModeAllOf requires every child check to be healthy.
+	ModeAllOf Mode = "all-of"
+	// ModeAnyOf requires at least one child check to be healthy.
+	ModeAnyOf Mode = "any-of"
+	// ModeQuorum requires at least a quorum of child checks to be healthy.
+	ModeQuorum Mode = "quorum"
+)
+
+// Check combines the results of several child checks into a single result.
+// A child check is healthy when it does not fail. The composite check passes when enough
+// children are healthy and all of them pass, warns when enough children are healthy but
+// some of them warn or fail, and fails otherwise.
+type Check struct {
+	name     string
+	children []checks.Check
+	mode     Mode
+	quorum   int
+}
+
+// Option is a functional option for configuring Check.
+type Option func(*Check)
+
+// WithName sets the name of the check.
+func WithName(name string) Option {
+	return func(c *Check) {
+		c.name = name
+	}
+}
+
+// WithChecks adds child checks to combine.
+func WithChecks(children ...checks.Check) Option {
+	return func(c *Check) {
+		c.children = append(c.children, children...)
+	}
+}
+
+// WithAllOf requires every child check to be healthy. This is the default.
+func WithAllOf() Option {
+	return func(c *Check) {
+		c.mode = ModeAllOf
+	}
+}
+
+// WithAnyOf requires at least one child check to be healthy.
+func WithAnyOf() Option {
+	return func(c *Check) {
+		c.mode = ModeAnyOf
+	}
+}
+
+// WithQuorum requires at least k child checks to be healthy.
+func WithQuorum(k int) Option {
+	return func(c *Check) {
+		c.mode = ModeQuorum
+		c.quorum = k
+	}
+}
+
+// NewCheck creates a new composite Check instance with optional configuration.
+func NewCheck(opts ...Option) *Check {
+	check := &Check{
+		name: "composite-check",
+		mode: ModeAllOf,
+	}
+
+	for _, opt := range opts {
+		opt(check)
+	}
+
+	return check
+}
+
+// GetName returns the name of the check.
+func (c *Check) GetName() string {
+	return c.name
+}
+
+// Run executes the child checks concurrently and returns a result that summarizes them.
+// The individual results of the children are nested in the Checks field of the result.
+func (c *Check) Run(ctx context.Context) checks.Result {
+	result := checks.Result{
+		Status: checks.StatusPass,
+		Time:   time.Now(),
+	}
+
+	if len(c.children) == 0 {
+		result.Status = checks.StatusFail
+		result.Output = "at least one check is required"
+		return result
+	}
+
+	required := c.required()
+	if required < 1 || required > len(c.children) {
+		result.Status = checks.StatusFail
+		result.Output = fmt.Sprintf("invalid quorum: %d (must be 1-%d)", required, len(c.children))
+		return result
+	}
+
+	result.Checks = c.runChildren(ctx)
+
+	healthy := 0
+	var problems []string
+	for _, name := range slices.Sorted(maps.Keys(result.Checks)) {
+		for _, childResult := range result.Checks[name] {
+			if childResult.Status != checks.StatusFail {
+				healthy++
+			}
+			if childResult.Status != checks.StatusPass {
+				problem := name + " " + string(childResult.Status)
+				if childResult.Output != "" {
+					problem += ": " + childResult.Output
+				}
+				problems = append(problems, problem)
+			}
+		}
+	}
+
+	result.ObservedValue = healthy
+
+	switch {
+	case healthy < required:
+		result.Status = checks.StatusFail
+	case len(problems) > 0:
+		result.Status = checks.StatusWarn
+	default:
+		return result
+	}
+
+	result.Output = fmt.Sprintf("%d of %d checks healthy (%s requires %d); %s",
+		healthy, len(c.children), c.mode, required, strings.Join(problems, "; "))
+
+	return result
+}
+
+// required returns the number of healthy children required for the check to be healthy.
+func (c *Check) required() int {
+	switch c.mode {
+	case ModeAnyOf:
+		return 1
+	case ModeQuorum:
+		return c.quorum
+	default:
+		return len(c.children)
+	}
+}
+
+// runChildren runs the child checks concurrently and groups their results by name.
+func (c *Check) runChildren(ctx context.Context) map[string][]checks.Result {
+	type childResult struct {
+		name   string
+		result checks.Result
+	}
+
+	resultsChan := make(chan childResult, len(c.children))
+	for _, child := range c.children {
+		go func(child checks.Check) {
+			resultsChan <- childResult{
+				name:   child.GetName(),
+				result: runSafely(ctx, child),
+			}
+		}(child)
+	}
+
+	results := make(map[string][]checks.Result, len(c.children))
+	for range c.children {
+		cr := <-resultsChan
+		results[cr.name] = append(results[cr.name], cr.result)
+	}
+
+	return results
+}
+
+// runSafely runs the child check, converting a panic into a StatusFail result,
+// since the panic isolation of the HealthCheck does not cover the goroutines of the children.
+func runSafely(ctx context.Context, child checks.Check) (result checks.Result) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result = checks.Result{
+				Status: checks.StatusFail,
+				Output: fmt.Sprintf("check panicked: %v", recovered),
+				Time:   time.Now(),
+			}
+		}
+	}()
+
+	return child.Run(ctx)
+}
+
+// Validate verifies the configuration of the check: at least one check is required, the quorum must be
+// between 1 and the number of checks, and the configuration of every check must be valid.
+func (c *Check) Validate() error {
+	if len(c.children) == 0 {
+		return errors.New("at least one check is required")
+	}
+
+	var errs []error
+
+	if required := c.required(); required < 1 || required > len(c.children) {
+		errs = append(errs, fmt.Errorf("invalid quorum: %d (must be 1-%d)", required, len(c.children)))
+	}
+
+	for _, child := range c.children {
+		if err := checks.Validate(child); err != nil {
+			errs = append(errs, fmt.Errorf("check %q: %w", child.GetName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,197 @@
+package compositecheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/compositecheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/diskcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func newChild(name string, status checks.Status) checks.Check {
+	return mockcheck.NewCheck(
+		mockcheck.WithName(name),
+		mockcheck.WithStatus(status),
+	)
+}
+
+func TestCompositeCheck_New(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates check with default values", func(t *testing.T) {
+		t.Parallel()
+
+		check := compositecheck.NewCheck()
+		assert.Equal(t, "composite-check", check.GetName())
+	})
+
+	t.Run("creates check with custom name", func(t *testing.T) {
+		t.Parallel()
+
+		check := compositecheck.NewCheck(compositecheck.WithName("etcd"))
+		assert.Equal(t, "etcd", check.GetName())
+	})
+}
+
+func TestCompositeCheck_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fails without child checks", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck().Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "at least one check is required", result.Output)
+	})
+
+	t.Run("fails with invalid quorum", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithChecks(newChild("a", checks.StatusPass)),
+			compositecheck.WithQuorum(2),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "invalid quorum: 2 (must be 1-1)", result.Output)
+	})
+
+	t.Run("all of passes when every child passes", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithChecks(newChild("a", checks.StatusPass), newChild("b", checks.StatusPass)),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Empty(t, result.Output)
+		assert.Equal(t, 2, result.ObservedValue)
+		assert.Len(t, result.Checks, 2)
+	})
+
+	t.Run("all of fails when one child fails", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithAllOf(),
+			compositecheck.WithChecks(newChild("a", checks.StatusPass), newChild("b", checks.StatusFail)),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "1 of 2 checks healthy (all-of requires 2); b fail: check failed", result.Output)
+		assert.Equal(t, checks.StatusFail, result.Checks["b"][0].Status)
+	})
+
+	t.Run("all of warns when one child warns", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithChecks(newChild("a", checks.StatusPass), newChild("b", checks.StatusWarn)),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+	})
+
+	t.Run("any of warns when one child passes", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithAnyOf(),
+			compositecheck.WithChecks(newChild("mirror-1", checks.StatusFail), newChild("mirror-2", checks.StatusPass)),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, 1, result.ObservedValue)
+	})
+
+	t.Run("any of fails when every child fails", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithAnyOf(),
+			compositecheck.WithChecks(newChild("mirror-1", checks.StatusFail), newChild("mirror-2", checks.StatusFail)),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+	})
+
+	t.Run("quorum warns when quorum is reached", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithQuorum(2),
+			compositecheck.WithChecks(
+				newChild("etcd-1", checks.StatusPass),
+				newChild("etcd-2", checks.StatusPass),
+				newChild("etcd-3", checks.StatusFail),
+			),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, "2 of 3 checks healthy (quorum requires 2); etcd-3 fail: check failed", result.Output)
+	})
+
+	t.Run("quorum fails when quorum is lost", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithQuorum(2),
+			compositecheck.WithChecks(
+				newChild("etcd-1", checks.StatusPass),
+				newChild("etcd-2", checks.StatusFail),
+				newChild("etcd-3", checks.StatusFail),
+			),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, 1, result.ObservedValue)
+	})
+
+	t.Run("reports panicking child as failed", func(t *testing.T) {
+		t.Parallel()
+
+		result := compositecheck.NewCheck(
+			compositecheck.WithAnyOf(),
+			compositecheck.WithChecks(
+				newChild("primary", checks.StatusPass),
+				mockcheck.NewCheck(mockcheck.WithName("replica"), mockcheck.WithPanic("boom")),
+			),
+		).Run(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["replica"][0].Status)
+		assert.Equal(t, "check panicked: boom", result.Checks["replica"][0].Output)
+	})
+}
+
+func TestCompositeCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requires child checks", func(t *testing.T) {
+		t.Parallel()
+
+		assert.EqualError(t, compositecheck.NewCheck().Validate(), "at least one check is required")
+	})
+
+	t.Run("reports invalid quorum and child checks", func(t *testing.T) {
+		t.Parallel()
+
+		check := compositecheck.NewCheck(
+			compositecheck.WithChecks(
+				mockcheck.NewCheck(mockcheck.WithName("primary")),
+				diskcheck.NewCheck(diskcheck.WithPath("")),
+			),
+			compositecheck.WithQuorum(3),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, "invalid quorum: 3 (must be 1-2)")
+		assert.ErrorContains(t, err, `check "disk-check": path is required`)
+	})
+}
@@ -3,6 +3,7 @@ package dbcheck
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -93,9 +94,10 @@ func (c *OpenConnectionsCheck) Run(ctx context.Context) checks.Result {
 	now := time.Now()
 	if c.db == nil {
 		return checks.Result{
-			Status: checks.StatusFail,
-			Output: "database connection is required",
-			Time:   now,
+			ComponentType: checks.ComponentTypeDatastore,
+			Status:        checks.StatusFail,
+			Output:        "database connection is required",
+			Time:          now,
 		}
 	}
 
@@ -107,9 +109,10 @@ func (c *OpenConnectionsCheck) Run(ctx context.Context) checks.Result {
 	select {
 	case <-checkCtx.Done():
 		return checks.Result{
-			Status: checks.StatusFail,
-			Output: "operation timed out",
-			Time:   now,
+			ComponentType: checks.ComponentTypeDatastore,
+			Status:        checks.StatusFail,
+			Output:        "operation timed out",
+			Time:          now,
 		}
 	default:
 	}
@@ -121,8 +124,9 @@ func (c *OpenConnectionsCheck) Run(ctx context.Context) checks.Result {
 
 	if maxConnections <= 0 {
 		return checks.Result{
-			Status: checks.StatusPass,
-			Time:   now,
+			ComponentType: checks.ComponentTypeDatastore,
+			Status:        checks.StatusPass,
+			Time:          now,
 		}
 	}
 
@@ -133,6 +137,7 @@ func (c *OpenConnectionsCheck) Run(ctx context.Context) checks.Result {
 	// Check if open connections exceed the failure threshold
 	if openConnections >= failThresholdConnections {
 		return checks.Result{
+			ComponentType: checks.ComponentTypeDatastore,
 			Status:        checks.StatusFail,
 			Output:        fmt.Sprintf("open connections (%d) exceed failure threshold (%d)", openConnections, failThresholdConnections),
 			Time:          now,
@@ -143,6 +148,7 @@ func (c *OpenConnectionsCheck) Run(ctx context.Context) checks.Result {
 	// Check if we're approaching the limit (warn threshold)
 	if openConnections >= warnThresholdConnections {
 		return checks.Result{
+			ComponentType: checks.ComponentTypeDatastore,
 			Status:        checks.StatusWarn,
 			Output:        fmt.Sprintf("open connections (%d) approaching maximum (%d)", openConnections, maxConnections),
 			Time:          now,
@@ -151,8 +157,38 @@ func (c *OpenConnectionsCheck) Run(ctx context.Context) checks.Result {
 	}
 
 	return checks.Result{
+		ComponentType: checks.ComponentTypeDatastore,
 		Status:        checks.StatusPass,
 		Time:          now,
 		ObservedValue: openConnections,
 	}
 }
+
+// Validate verifies the configuration of the connections check: the database connection is required,
+// the timeout must be positive and the thresholds must be percentages with the warn threshold
+// not above the fail threshold.
+func (c *OpenConnectionsCheck) Validate() error {
+	var errs []error
+
+	if c.db == nil {
+		errs = append(errs, errors.New("database connection is required"))
+	}
+
+	if c.timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive, got %s", c.timeout))
+	}
+
+	if c.warnThreshold < 0 || c.warnThreshold > 100 {
+		errs = append(errs, fmt.Errorf("warn threshold must be between 0 and 100, got %.1f", c.warnThreshold))
+	}
+
+	if c.failThreshold < 0 || c.failThreshold > 100 {
+		errs = append(errs, fmt.Errorf("fail threshold must be between 0 and 100, got %.1f", c.failThreshold))
+	}
+
+	if c.warnThreshold > c.failThreshold {
+		errs = append(errs, fmt.Errorf("warn threshold %.1f is above fail threshold %.1f", c.warnThreshold, c.failThreshold))
+	}
+
+	return errors.Join(errs...)
+}
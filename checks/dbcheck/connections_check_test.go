@@ -44,6 +44,7 @@ func TestConnectionsCheck_Run(t *testing.T) {
 
 		assert.Equal(t, checks.StatusPass, result.Status)
 		assert.Equal(t, "test-connections-check", check.GetName())
+		assert.Equal(t, checks.ComponentTypeDatastore, result.ComponentType)
 		assert.Equal(t, 10, result.ObservedValue)
 		mockDB.AssertExpectations(t)
 	})
@@ -228,3 +229,29 @@ func TestConnectionsCheck_Run(t *testing.T) {
 		mockDB.AssertExpectations(t)
 	})
 }
+
+func TestConnectionsCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts valid configuration", func(t *testing.T) {
+		t.Parallel()
+
+		check := dbcheck.NewOpenConnectionsCheck(dbcheck.WithOpenConnectionsDB(&MockDatabaseStatsProvider{}))
+
+		assert.NoError(t, check.Validate())
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		check := dbcheck.NewOpenConnectionsCheck(
+			dbcheck.WithOpenConnectionsWarnThreshold(95),
+			dbcheck.WithOpenConnectionsFailThreshold(90),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, "database connection is required")
+		assert.ErrorContains(t, err, "warn threshold 95.0 is above fail threshold 90.0")
+	})
+}
@@ -2,6 +2,8 @@ package dbcheck
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/brpaz/go-healthcheck/v2/checks"
@@ -70,9 +72,10 @@ func (c *PingCheck) GetName() string {
 func (c *PingCheck) Run(ctx context.Context) checks.Result {
 	if c.db == nil {
 		return checks.Result{
-			Status: checks.StatusFail,
-			Output: "database connection is required",
-			Time:   time.Now(),
+			ComponentType: checks.ComponentTypeDatastore,
+			Status:        checks.StatusFail,
+			Output:        "database connection is required",
+			Time:          time.Now(),
 		}
 	}
 
@@ -87,18 +90,36 @@ func (c *PingCheck) Run(ctx context.Context) checks.Result {
 	// Check if the database is reachable with Ping
 	if err := c.db.PingContext(queryCtx); err != nil {
 		return checks.Result{
-			Status: checks.StatusFail,
-			Output: "database ping failed: " + err.Error(),
-			Time:   now,
+			ComponentType: checks.ComponentTypeDatastore,
+			Status:        checks.StatusFail,
+			Output:        "database ping failed: " + err.Error(),
+			Time:          now,
 		}
 	}
 
 	duration := time.Since(startTime)
 
 	return checks.Result{
+		ComponentType: checks.ComponentTypeDatastore,
 		Status:        checks.StatusPass,
 		Time:          now,
-		ObservedUnit:  "ms",
+		ObservedUnit:  checks.UnitMilliseconds,
 		ObservedValue: duration.Milliseconds(),
 	}
 }
+
+// Validate verifies the configuration of the ping check: the database connection is required
+// and the timeout must be positive.
+func (c *PingCheck) Validate() error {
+	var errs []error
+
+	if c.db == nil {
+		errs = append(errs, errors.New("database connection is required"))
+	}
+
+	if c.timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive, got %s", c.timeout))
+	}
+
+	return errors.Join(errs...)
+}
@@ -40,6 +40,7 @@ func TestPingCheck_Run(t *testing.T) {
 
 		assert.Equal(t, checks.StatusPass, result.Status)
 		assert.Equal(t, "test-db-check", check.GetName())
+		assert.Equal(t, checks.ComponentTypeDatastore, result.ComponentType)
 		mockDB.AssertExpectations(t)
 	})
 
@@ -75,3 +76,24 @@ func TestPingCheck_Run(t *testing.T) {
 		assert.Equal(t, "database connection is required", result.Output)
 	})
 }
+
+func TestPingCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts valid configuration", func(t *testing.T) {
+		t.Parallel()
+
+		check := dbcheck.NewPingCheck(dbcheck.WithPingDB(&MockDatabasePinger{}))
+
+		assert.NoError(t, check.Validate())
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		err := dbcheck.NewPingCheck(dbcheck.WithPingTimeout(0)).Validate()
+
+		assert.ErrorContains(t, err, "database connection is required")
+		assert.ErrorContains(t, err, "timeout must be positive, got 0s")
+	})
+}
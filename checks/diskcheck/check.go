@@ -4,10 +4,13 @@ package diskcheck
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/internal/threshold"
 )
 
 const (
@@ -30,7 +33,12 @@ type Check struct {
 	path          string
 	warnThreshold float64 // Percentage of disk usage that triggers warning
 	failThreshold float64 // Percentage of disk usage that triggers failure
+	warnRecovery  float64 // Percentage of disk usage below which a warning recovers
+	failRecovery  float64 // Percentage of disk usage below which a failure recovers
 	stater        FileSystemStater
+
+	mu         sync.Mutex
+	lastStatus checks.Status
 }
 
 // Option is a functional option for configuring Check.
@@ -64,6 +72,24 @@ func WithFailThreshold(threshold float64) Option {
 	}
 }
 
+// WithWarnRecoveryThreshold sets the disk usage percentage below which a warning status recovers.
+// While the usage stays between this value and the warn threshold, the warning is held.
+// By default, the status recovers as soon as the usage drops below the warn threshold.
+func WithWarnRecoveryThreshold(threshold float64) Option {
+	return func(c *Check) {
+		c.warnRecovery = threshold
+	}
+}
+
+// WithFailRecoveryThreshold sets the disk usage percentage below which a failure status recovers.
+// While the usage stays between this value and the fail threshold, the failure is held.
+// By default, the status recovers as soon as the usage drops below the fail threshold.
+func WithFailRecoveryThreshold(threshold float64) Option {
+	return func(c *Check) {
+		c.failRecovery = threshold
+	}
+}
+
 // WithFileSystemStater sets a custom filesystem stater (useful for testing).
 func WithFileSystemStater(stater FileSystemStater) Option {
 	return func(c *Check) {
@@ -98,8 +124,9 @@ func (c *Check) GetName() string {
 // TODO: Split into separate checks per path.
 func (c *Check) Run(ctx context.Context) checks.Result {
 	result := checks.Result{
-		Status: checks.StatusPass,
-		Time:   time.Now(),
+		ComponentType: checks.ComponentTypeSystem,
+		Status:        checks.StatusPass,
+		Time:          time.Now(),
 	}
 
 	diskInfo, err := c.stater.Statfs(c.path)
@@ -111,7 +138,7 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 
 	result.Status = checks.StatusPass
 	result.ObservedValue = diskInfo.UsedPct
-	result.ObservedUnit = "%"
+	result.ObservedUnit = checks.UnitPercent
 
 	// Check thresholds
 	if diskInfo.UsedPct >= c.failThreshold {
@@ -123,9 +150,34 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 		result.Output = fmt.Sprintf("disk usage high: %.1f%% used (threshold: %.1f%%)",
 			diskInfo.UsedPct, c.warnThreshold)
 	}
+
+	c.applyRecovery(&result, diskInfo.UsedPct)
+
 	return result
 }
 
+// applyRecovery holds a previous warn or fail status while the usage has not dropped below
+// the corresponding recovery threshold, and records the reported status.
+func (c *Check) applyRecovery(result *checks.Result, usedPct float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case c.lastStatus == checks.StatusFail && result.Status != checks.StatusFail &&
+		c.failRecovery > 0 && usedPct >= c.failRecovery:
+		result.Status = checks.StatusFail
+		result.Output = fmt.Sprintf("disk usage critical: %.1f%% used, holding fail status until usage drops below %.1f%%",
+			usedPct, c.failRecovery)
+	case (c.lastStatus == checks.StatusFail || c.lastStatus == checks.StatusWarn) && result.Status == checks.StatusPass &&
+		c.warnRecovery > 0 && usedPct >= c.warnRecovery:
+		result.Status = checks.StatusWarn
+		result.Output = fmt.Sprintf("disk usage high: %.1f%% used, holding warn status until usage drops below %.1f%%",
+			usedPct, c.warnRecovery)
+	}
+
+	c.lastStatus = result.Status
+}
+
 // GetDiskInfo returns disk information for all monitored paths
 func (c *Check) GetDiskInfo() ([]*DiskInfo, error) {
 	info, err := c.stater.Statfs(c.path)
@@ -134,3 +186,22 @@ func (c *Check) GetDiskInfo() ([]*DiskInfo, error) {
 	}
 	return []*DiskInfo{info}, nil
 }
+
+// Validate verifies the configuration of the check: the path and file system stater are required,
+// the thresholds must be percentages with the warn threshold not above the fail threshold, and the
+// recovery thresholds, when set, must be below the threshold they recover from.
+func (c *Check) Validate() error {
+	var errs []error
+
+	if c.path == "" {
+		errs = append(errs, errors.New("path is required"))
+	}
+
+	if c.stater == nil {
+		errs = append(errs, errors.New("file system stater is required"))
+	}
+
+	errs = append(errs, threshold.Validate(c.warnThreshold, c.failThreshold, c.warnRecovery, c.failRecovery))
+
+	return errors.Join(errs...)
+}
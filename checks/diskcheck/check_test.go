@@ -96,6 +96,7 @@ func TestDiskCheck_Run(t *testing.T) {
 		assert.Equal(t, checks.StatusPass, result.Status)
 		assert.Equal(t, 50.0, result.ObservedValue)
 		assert.Equal(t, "%", result.ObservedUnit)
+		assert.Equal(t, checks.ComponentTypeSystem, result.ComponentType)
 
 		mockStater.AssertExpectations(t)
 	})
@@ -370,3 +371,108 @@ func TestDiskCheck_Run_ThresholdScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestDiskCheck_RecoveryThresholds(t *testing.T) {
+	t.Parallel()
+
+	t.Run("holds warn status until usage drops below recovery threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockStater := &MockFileSystemStater{}
+		mockStater.On("Statfs", "/").Return(&diskcheck.DiskInfo{Path: "/", UsedPct: 81.0}, nil).Once()
+		mockStater.On("Statfs", "/").Return(&diskcheck.DiskInfo{Path: "/", UsedPct: 78.0}, nil).Once()
+		mockStater.On("Statfs", "/").Return(&diskcheck.DiskInfo{Path: "/", UsedPct: 74.0}, nil).Once()
+
+		check := diskcheck.NewCheck(
+			diskcheck.WithWarnThreshold(80.0),
+			diskcheck.WithWarnRecoveryThreshold(75.0),
+			diskcheck.WithFileSystemStater(mockStater),
+		)
+
+		assert.Equal(t, checks.StatusWarn, check.Run(context.Background()).Status)
+
+		result := check.Run(context.Background())
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, "disk usage high: 78.0% used, holding warn status until usage drops below 75.0%", result.Output)
+
+		assert.Equal(t, checks.StatusPass, check.Run(context.Background()).Status)
+		mockStater.AssertExpectations(t)
+	})
+
+	t.Run("holds fail status until usage drops below recovery threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockStater := &MockFileSystemStater{}
+		mockStater.On("Statfs", "/").Return(&diskcheck.DiskInfo{Path: "/", UsedPct: 92.0}, nil).Once()
+		mockStater.On("Statfs", "/").Return(&diskcheck.DiskInfo{Path: "/", UsedPct: 88.0}, nil).Once()
+		mockStater.On("Statfs", "/").Return(&diskcheck.DiskInfo{Path: "/", UsedPct: 70.0}, nil).Once()
+
+		check := diskcheck.NewCheck(
+			diskcheck.WithFailRecoveryThreshold(85.0),
+			diskcheck.WithFileSystemStater(mockStater),
+		)
+
+		assert.Equal(t, checks.StatusFail, check.Run(context.Background()).Status)
+
+		result := check.Run(context.Background())
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Contains(t, result.Output, "holding fail status until usage drops below 85.0%")
+
+		assert.Equal(t, checks.StatusPass, check.Run(context.Background()).Status)
+		mockStater.AssertExpectations(t)
+	})
+}
+
+func TestDiskCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts default configuration", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, diskcheck.NewCheck().Validate())
+	})
+
+	t.Run("accepts recovery thresholds", func(t *testing.T) {
+		t.Parallel()
+
+		check := diskcheck.NewCheck(
+			diskcheck.WithWarnRecoveryThreshold(75),
+			diskcheck.WithFailRecoveryThreshold(85),
+		)
+
+		assert.NoError(t, check.Validate())
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		check := diskcheck.NewCheck(
+			diskcheck.WithPath(""),
+			diskcheck.WithWarnThreshold(95),
+			diskcheck.WithFailThreshold(120),
+			diskcheck.WithWarnRecoveryThreshold(96),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, "path is required")
+		assert.ErrorContains(t, err, "fail threshold must be between 0 and 100, got 120.0")
+		assert.ErrorContains(t, err, "warn recovery threshold 96.0 must be below warn threshold 95.0")
+	})
+
+	t.Run("rejects warn threshold above fail threshold", func(t *testing.T) {
+		t.Parallel()
+
+		check := diskcheck.NewCheck(diskcheck.WithWarnThreshold(95))
+
+		assert.EqualError(t, check.Validate(), "warn threshold 95.0 is above fail threshold 90.0")
+	})
+
+	t.Run("requires file system stater", func(t *testing.T) {
+		t.Parallel()
+
+		check := diskcheck.NewCheck(diskcheck.WithFileSystemStater(nil))
+
+		assert.EqualError(t, check.Validate(), "file system stater is required")
+	})
+}
@@ -0,0 +1,161 @@
+// Package flapcheck provides a check decorator that dampens status flapping.
+// It holds back status transitions until the wrapped check has reported the new status
+// for a configured number of consecutive runs.
+package flapcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// Check wraps another check and only reports a worse status after a number of consecutive
+// unhealthy results, and a better status after a number of consecutive healthier results.
+type Check struct {
+	name             string
+	check            checks.Check
+	failureThreshold int
+	successThreshold int
+
+	mu       sync.Mutex
+	reported checks.Status
+	worse    int
+	better   int
+}
+
+// Option is a functional option for configuring Check.
+type Option func(*Check)
+
+// WithName sets the name of the check. By default, the name of the wrapped check is used.
+func WithName(name string) Option {
+	return func(c *Check) {
+		c.name = name
+	}
+}
+
+// WithCheck sets the check to wrap.
+func WithCheck(check checks.Check) Option {
+	return func(c *Check) {
+		c.check = check
+	}
+}
+
+// WithFailureThreshold sets the number of consecutive unhealthier results required
+// before a worse status is reported (default: 3).
+func WithFailureThreshold(threshold int) Option {
+	return func(c *Check) {
+		c.failureThreshold = threshold
+	}
+}
+
+// WithSuccessThreshold sets the number of consecutive healthier results required
+// before a recovery is reported (default: 1).
+func WithSuccessThreshold(threshold int) Option {
+	return func(c *Check) {
+		c.successThreshold = threshold
+	}
+}
+
+// NewCheck creates a new flap damping Check instance with optional configuration.
+// The check starts from the pass status.
+func NewCheck(opts ...Option) *Check {
+	check := &Check{
+		failureThreshold: 3,
+		successThreshold: 1,
+		reported:         checks.StatusPass,
+	}
+
+	for _, opt := range opts {
+		opt(check)
+	}
+
+	return check
+}
+
+// GetName returns the name of the check.
+func (c *Check) GetName() string {
+	if c.name == "" && c.check != nil {
+		return c.check.GetName()
+	}
+	return c.name
+}
+
+// Run executes the wrapped check and returns its result, with the status held back
+// while the configured number of consecutive results has not been reached.
+func (c *Check) Run(ctx context.Context) checks.Result {
+	if c.check == nil {
+		return checks.Result{
+			Status: checks.StatusFail,
+			Output: "check to wrap is required",
+			Time:   time.Now(),
+		}
+	}
+
+	result := c.check.Run(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case result.Status.Severity() > c.reported.Severity():
+		c.worse++
+		c.better = 0
+		if c.worse < c.failureThreshold {
+			return c.hold(result, fmt.Sprintf("%d of %d consecutive unhealthy results", c.worse, c.failureThreshold))
+		}
+	case result.Status.Severity() < c.reported.Severity():
+		c.better++
+		c.worse = 0
+		if c.better < c.successThreshold {
+			return c.hold(result, fmt.Sprintf("%d of %d consecutive healthy results", c.better, c.successThreshold))
+		}
+	}
+
+	c.reported = result.Status
+	c.worse = 0
+	c.better = 0
+
+	return result
+}
+
+// hold returns the result with the currently reported status, explaining in the output
+// why the actual status is being held back.
+func (c *Check) hold(result checks.Result, progress string) checks.Result {
+	output := fmt.Sprintf("holding %s status, actual status %s (%s)", c.reported, result.Status, progress)
+	if result.Output != "" {
+		output += ": " + result.Output
+	}
+
+	result.Status = c.reported
+	result.Output = output
+
+	return result
+}
+
+// Validate verifies the configuration of the check: the check to wrap is required, the thresholds
+// must be at least 1, and the configuration of the wrapped check must be valid.
+func (c *Check) Validate() error {
+	if c.check == nil {
+		return errors.New("check to wrap is required")
+	}
+
+	var errs []error
+
+	if c.failureThreshold < 1 {
+		errs = append(errs, fmt.Errorf("failure threshold must be at least 1, got %d", c.failureThreshold))
+	}
+
+	if c.successThreshold < 1 {
+		errs = append(errs, fmt.Errorf("success threshold must be at least 1, got %d", c.successThreshold))
+	}
+
+	if err := checks.Validate(c.check); err != nil {
+		errs = append(errs, fmt.Errorf("check %q: %w", c.check.GetName(), err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,149 @@
+package flapcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/diskcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/flapcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+// newSequenceCheck returns a mock check returning the given statuses in order, starting over after the last one.
+func newSequenceCheck(statuses ...checks.Status) *mockcheck.Check {
+	return mockcheck.NewCheck(
+		mockcheck.WithName("sequence"),
+		mockcheck.WithStatuses(statuses...),
+		mockcheck.WithOutput("connection refused"),
+	)
+}
+
+func runStatuses(check *flapcheck.Check, runs int) []checks.Status {
+	statuses := make([]checks.Status, 0, runs)
+	for range runs {
+		statuses = append(statuses, check.Run(context.Background()).Status)
+	}
+	return statuses
+}
+
+func TestFlapCheck_New(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uses name of the wrapped check by default", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(flapcheck.WithCheck(newSequenceCheck(checks.StatusPass)))
+		assert.Equal(t, "sequence", check.GetName())
+	})
+
+	t.Run("uses custom name", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(
+			flapcheck.WithName("damped"),
+			flapcheck.WithCheck(newSequenceCheck(checks.StatusPass)),
+		)
+		assert.Equal(t, "damped", check.GetName())
+	})
+}
+
+func TestFlapCheck_Run(t *testing.T) {
+	t.Parallel()
+
+	pass, warn, fail := checks.StatusPass, checks.StatusWarn, checks.StatusFail
+
+	t.Run("fails without wrapped check", func(t *testing.T) {
+		t.Parallel()
+
+		result := flapcheck.NewCheck().Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "check to wrap is required", result.Output)
+	})
+
+	t.Run("reports fail after consecutive failures", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(
+			flapcheck.WithCheck(newSequenceCheck(fail)),
+			flapcheck.WithFailureThreshold(3),
+		)
+
+		assert.Equal(t, []checks.Status{pass, pass, fail, fail}, runStatuses(check, 4))
+	})
+
+	t.Run("ignores isolated failures", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(
+			flapcheck.WithCheck(newSequenceCheck(pass, fail, pass, fail, fail, pass)),
+			flapcheck.WithFailureThreshold(3),
+		)
+
+		assert.Equal(t, []checks.Status{pass, pass, pass, pass, pass, pass}, runStatuses(check, 6))
+	})
+
+	t.Run("recovers after consecutive passes", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(
+			flapcheck.WithCheck(newSequenceCheck(fail, pass, pass, pass)),
+			flapcheck.WithFailureThreshold(1),
+			flapcheck.WithSuccessThreshold(2),
+		)
+
+		assert.Equal(t, []checks.Status{fail, fail, pass, pass}, runStatuses(check, 4))
+	})
+
+	t.Run("treats warn as unhealthier than pass", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(
+			flapcheck.WithCheck(newSequenceCheck(warn, warn, fail, fail)),
+			flapcheck.WithFailureThreshold(2),
+		)
+
+		assert.Equal(t, []checks.Status{pass, warn, warn, fail}, runStatuses(check, 4))
+	})
+
+	t.Run("explains held back status in output", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(
+			flapcheck.WithCheck(newSequenceCheck(fail)),
+			flapcheck.WithFailureThreshold(3),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, "holding pass status, actual status fail (1 of 3 consecutive unhealthy results): connection refused", result.Output)
+	})
+}
+
+func TestFlapCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requires wrapped check", func(t *testing.T) {
+		t.Parallel()
+
+		assert.EqualError(t, flapcheck.NewCheck().Validate(), "check to wrap is required")
+	})
+
+	t.Run("reports invalid thresholds and wrapped check", func(t *testing.T) {
+		t.Parallel()
+
+		check := flapcheck.NewCheck(
+			flapcheck.WithCheck(diskcheck.NewCheck(diskcheck.WithPath(""))),
+			flapcheck.WithFailureThreshold(0),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, "failure threshold must be at least 1, got 0")
+		assert.ErrorContains(t, err, `check "disk-check": path is required`)
+	})
+}
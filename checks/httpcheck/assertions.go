@@ -0,0 +1,241 @@
+package httpcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// defaultMaxBodySize is the default maximum number of bytes of the response body read for assertions.
+const defaultMaxBodySize = 1 << 20
+
+// response is the response of the checked endpoint, as seen by the assertions.
+type response struct {
+	header http.Header
+	body   []byte
+
+	// doc is the body decoded as JSON, decoded by the first JSON path assertion.
+	doc     any
+	docErr  error
+	decoded bool
+}
+
+// assertion verifies the response, returning an error describing why it does not match.
+type assertion func(resp *response) error
+
+// WithBodyContains asserts that the response body contains the given substring.
+func WithBodyContains(substr string) Option {
+	return func(c *Check) {
+		c.addBodyAssertion(func(resp *response) error {
+			if !bytes.Contains(resp.body, []byte(substr)) {
+				return fmt.Errorf("response body does not contain %q", substr)
+			}
+			return nil
+		})
+	}
+}
+
+// WithBodyMatches asserts that the response body matches the given regular expression.
+// An invalid expression is reported by Validate, and fails the check.
+func WithBodyMatches(pattern string) Option {
+	return func(c *Check) {
+		re, ok := c.compile(pattern)
+		if !ok {
+			return
+		}
+		c.addBodyAssertion(func(resp *response) error {
+			if !re.Match(resp.body) {
+				return fmt.Errorf("response body does not match %q", pattern)
+			}
+			return nil
+		})
+	}
+}
+
+// WithJSONPathEquals asserts that the value at the given path of the JSON response body equals the expected value.
+// The path is a dot-separated list of object keys and array indexes, such as "status" or "checks.0.status",
+// optionally prefixed with "$.". Values other than strings are compared using their JSON representation,
+// such as "true" or "42".
+func WithJSONPathEquals(path, expected string) Option {
+	return func(c *Check) {
+		c.addBodyAssertion(func(resp *response) error {
+			value, err := resp.jsonPath(path)
+			if err != nil {
+				return err
+			}
+			if value != expected {
+				return fmt.Errorf("JSON path %q: expected %q, got %q", path, expected, value)
+			}
+			return nil
+		})
+	}
+}
+
+// WithJSONPathMatches asserts that the value at the given path of the JSON response body matches the given
+// regular expression. Paths and values are handled as in WithJSONPathEquals.
+// An invalid expression is reported by Validate, and fails the check.
+func WithJSONPathMatches(path, pattern string) Option {
+	return func(c *Check) {
+		re, ok := c.compile(pattern)
+		if !ok {
+			return
+		}
+		c.addBodyAssertion(func(resp *response) error {
+			value, err := resp.jsonPath(path)
+			if err != nil {
+				return err
+			}
+			if !re.MatchString(value) {
+				return fmt.Errorf("JSON path %q: %q does not match %q", path, value, pattern)
+			}
+			return nil
+		})
+	}
+}
+
+// WithResponseHeader asserts that the response has the given header with the expected value.
+func WithResponseHeader(name, expected string) Option {
+	return func(c *Check) {
+		c.assertions = append(c.assertions, func(resp *response) error {
+			values, ok := resp.header[http.CanonicalHeaderKey(name)]
+			if !ok {
+				return fmt.Errorf("response header %q is missing", name)
+			}
+			if values[0] != expected {
+				return fmt.Errorf("response header %q: expected %q, got %q", name, expected, values[0])
+			}
+			return nil
+		})
+	}
+}
+
+// WithResponseHeaderMatches asserts that the response has the given header with a value matching
+// the given regular expression. An invalid expression is reported by Validate, and fails the check.
+func WithResponseHeaderMatches(name, pattern string) Option {
+	return func(c *Check) {
+		re, ok := c.compile(pattern)
+		if !ok {
+			return
+		}
+		c.assertions = append(c.assertions, func(resp *response) error {
+			values, ok := resp.header[http.CanonicalHeaderKey(name)]
+			if !ok {
+				return fmt.Errorf("response header %q is missing", name)
+			}
+			if !re.MatchString(values[0]) {
+				return fmt.Errorf("response header %q: %q does not match %q", name, values[0], pattern)
+			}
+			return nil
+		})
+	}
+}
+
+// WithMaxBodySize sets the maximum number of bytes of the response body read for the body assertions
+// (default: 1 MiB). The check fails if the response body is larger.
+func WithMaxBodySize(size int64) Option {
+	return func(c *Check) {
+		c.maxBodySize = size
+	}
+}
+
+// addBodyAssertion adds an assertion that needs the response body.
+func (c *Check) addBodyAssertion(a assertion) {
+	c.assertions = append(c.assertions, a)
+	c.readBody = true
+}
+
+// compile compiles the regular expression of an assertion, recording the error if it is invalid.
+func (c *Check) compile(pattern string) (*regexp.Regexp, bool) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		c.errs = append(c.errs, fmt.Errorf("invalid regular expression %q: %w", pattern, err))
+		return nil, false
+	}
+	return re, true
+}
+
+// readResponse reads the response for the assertions, reading the body only if an assertion needs it.
+func (c *Check) readResponse(resp *http.Response) (*response, error) {
+	r := &response{header: resp.Header}
+	if !c.readBody {
+		return r, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if int64(len(body)) > c.maxBodySize {
+		return nil, fmt.Errorf("response body exceeds %d bytes", c.maxBodySize)
+	}
+
+	r.body = body
+	return r, nil
+}
+
+// assert runs the assertions against the response, returning the error of the first one that fails.
+func (c *Check) assert(resp *response) error {
+	for _, a := range c.assertions {
+		if err := a(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonPath returns the value at the given path of the JSON body, as a string.
+func (r *response) jsonPath(path string) (string, error) {
+	if !r.decoded {
+		r.decoded = true
+		decoder := json.NewDecoder(bytes.NewReader(r.body))
+		decoder.UseNumber()
+		r.docErr = decoder.Decode(&r.doc)
+	}
+	if r.docErr != nil {
+		return "", fmt.Errorf("response body is not valid JSON: %w", r.docErr)
+	}
+
+	value := r.doc
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if trimmed != "" {
+		for _, key := range strings.Split(trimmed, ".") {
+			var ok bool
+			switch v := value.(type) {
+			case map[string]any:
+				value, ok = v[key]
+			case []any:
+				var i int
+				i, ok = parseIndex(key, len(v))
+				if ok {
+					value = v[i]
+				}
+			}
+			if !ok {
+				return "", fmt.Errorf("JSON path %q not found in response body", path)
+			}
+		}
+	}
+
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("JSON path %q: %w", path, err)
+	}
+	return string(encoded), nil
+}
+
+// parseIndex parses an array index, reporting whether it is within the bounds of an array of the given length.
+func parseIndex(key string, length int) (int, bool) {
+	i, err := strconv.Atoi(key)
+	if err != nil || i < 0 || i >= length {
+		return 0, false
+	}
+	return i, true
+}
@@ -0,0 +1,199 @@
+package httpcheck_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/httpcheck"
+)
+
+// newBodyServer returns a test server answering every request with the given content type and body.
+func newBodyServer(t *testing.T, contentType, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestHTTPCheck_Assertions(t *testing.T) {
+	t.Parallel()
+
+	const degraded = `{"status": "degraded", "uptime": 42, "checks": [{"name": "db", "ok": true}]}`
+
+	tests := []struct {
+		name       string
+		opts       []httpcheck.Option
+		wantStatus checks.Status
+		wantOutput string
+	}{
+		{
+			name:       "body contains",
+			opts:       []httpcheck.Option{httpcheck.WithBodyContains(`"uptime": 42`)},
+			wantStatus: checks.StatusPass,
+		},
+		{
+			name:       "body does not contain",
+			opts:       []httpcheck.Option{httpcheck.WithBodyContains(`"status": "ok"`)},
+			wantStatus: checks.StatusFail,
+			wantOutput: `response body does not contain "\"status\": \"ok\""`,
+		},
+		{
+			name:       "body matches",
+			opts:       []httpcheck.Option{httpcheck.WithBodyMatches(`"uptime": \d+`)},
+			wantStatus: checks.StatusPass,
+		},
+		{
+			name:       "body does not match",
+			opts:       []httpcheck.Option{httpcheck.WithBodyMatches(`^OK$`)},
+			wantStatus: checks.StatusFail,
+			wantOutput: `response body does not match "^OK$"`,
+		},
+		{
+			name:       "json path equals",
+			opts:       []httpcheck.Option{httpcheck.WithJSONPathEquals("$.checks.0.name", "db")},
+			wantStatus: checks.StatusPass,
+		},
+		{
+			name: "json path equals non-string values",
+			opts: []httpcheck.Option{
+				httpcheck.WithJSONPathEquals("uptime", "42"),
+				httpcheck.WithJSONPathEquals("checks.0.ok", "true"),
+			},
+			wantStatus: checks.StatusPass,
+		},
+		{
+			name:       "json path does not equal",
+			opts:       []httpcheck.Option{httpcheck.WithJSONPathEquals("status", "ok")},
+			wantStatus: checks.StatusFail,
+			wantOutput: `JSON path "status": expected "ok", got "degraded"`,
+		},
+		{
+			name:       "json path matches",
+			opts:       []httpcheck.Option{httpcheck.WithJSONPathMatches("status", "^(ok|degraded)$")},
+			wantStatus: checks.StatusPass,
+		},
+		{
+			name:       "json path does not match",
+			opts:       []httpcheck.Option{httpcheck.WithJSONPathMatches("status", "^ok$")},
+			wantStatus: checks.StatusFail,
+			wantOutput: `JSON path "status": "degraded" does not match "^ok$"`,
+		},
+		{
+			name:       "json path not found",
+			opts:       []httpcheck.Option{httpcheck.WithJSONPathEquals("checks.1.name", "cache")},
+			wantStatus: checks.StatusFail,
+			wantOutput: `JSON path "checks.1.name" not found in response body`,
+		},
+		{
+			name:       "response header",
+			opts:       []httpcheck.Option{httpcheck.WithResponseHeader("content-type", "application/json")},
+			wantStatus: checks.StatusPass,
+		},
+		{
+			name:       "response header mismatch",
+			opts:       []httpcheck.Option{httpcheck.WithResponseHeader("Content-Type", "text/plain")},
+			wantStatus: checks.StatusFail,
+			wantOutput: `response header "Content-Type": expected "text/plain", got "application/json"`,
+		},
+		{
+			name:       "response header matches",
+			opts:       []httpcheck.Option{httpcheck.WithResponseHeaderMatches("Content-Type", "^application/")},
+			wantStatus: checks.StatusPass,
+		},
+		{
+			name:       "response header missing",
+			opts:       []httpcheck.Option{httpcheck.WithResponseHeaderMatches("X-Version", ".+")},
+			wantStatus: checks.StatusFail,
+			wantOutput: `response header "X-Version" is missing`,
+		},
+		{
+			name: "body larger than max size",
+			opts: []httpcheck.Option{
+				httpcheck.WithBodyContains("degraded"),
+				httpcheck.WithMaxBodySize(16),
+			},
+			wantStatus: checks.StatusFail,
+			wantOutput: "response body exceeds 16 bytes",
+		},
+		{
+			name:       "invalid regular expression",
+			opts:       []httpcheck.Option{httpcheck.WithBodyMatches("(")},
+			wantStatus: checks.StatusFail,
+			wantOutput: `invalid regular expression "("`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newBodyServer(t, "application/json", degraded)
+			check := httpcheck.NewCheck(append([]httpcheck.Option{httpcheck.WithURL(server.URL)}, tt.opts...)...)
+
+			result := check.Run(context.Background())
+
+			assert.Equal(t, tt.wantStatus, result.Status)
+			assert.True(t, strings.HasPrefix(result.Output, tt.wantOutput), "output: %s", result.Output)
+		})
+	}
+
+	t.Run("reports invalid json", func(t *testing.T) {
+		t.Parallel()
+
+		server := newBodyServer(t, "text/plain", "OK")
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL(server.URL),
+			httpcheck.WithJSONPathEquals("status", "ok"),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Contains(t, result.Output, "response body is not valid JSON")
+	})
+
+	t.Run("skips assertions on unexpected status code", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL(server.URL),
+			httpcheck.WithBodyContains("ok"),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "unexpected status code: 503 Service Unavailable", result.Output)
+	})
+
+	t.Run("validate reports invalid assertions", func(t *testing.T) {
+		t.Parallel()
+
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL("http://localhost/health"),
+			httpcheck.WithJSONPathMatches("status", "[a-"),
+			httpcheck.WithMaxBodySize(0),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, `invalid regular expression "[a-"`)
+		assert.ErrorContains(t, err, "max body size must be positive, got 0")
+	})
+}
@@ -1,10 +1,14 @@
 // Package httpcheck provides HTTP url health checks.
-// It requests HTTP urls and verifies their availability based on status codes and response times.
+// It requests HTTP urls and verifies their availability based on status codes and response times,
+// and optionally on the headers and the body of the response.
 package httpcheck
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"slices"
 	"time"
 
@@ -17,9 +21,18 @@ const defaultTimeout = 5 * time.Second
 type Check struct {
 	name           string
 	url            string
+	method         string
+	headers        http.Header
+	headerFuncs    []HeaderFunc
+	body           []byte
+	basicAuth      *basicAuth
 	timeout        time.Duration
 	exceptedStatus []int
 	client         *http.Client
+	assertions     []assertion
+	readBody       bool
+	maxBodySize    int64
+	errs           []error
 }
 
 // Option is a functional option for configuring Check.
@@ -66,9 +79,12 @@ func NewCheck(opts ...Option) *Check {
 	check := &Check{
 		name:           "http-check",
 		url:            "",
+		method:         http.MethodGet,
+		headers:        make(http.Header),
 		timeout:        defaultTimeout,
 		exceptedStatus: nil,
 		client:         http.DefaultClient,
+		maxBodySize:    defaultMaxBodySize,
 	}
 
 	for _, opt := range opts {
@@ -87,23 +103,34 @@ func (c *Check) GetName() string {
 func (c *Check) Run(ctx context.Context) checks.Result {
 	if c.url == "" {
 		return checks.Result{
-			Status: checks.StatusFail,
-			Output: "URL is required for HTTP health check",
-			Time:   time.Now(),
+			ComponentType: checks.ComponentTypeComponent,
+			Status:        checks.StatusFail,
+			Output:        "URL is required for HTTP health check",
+			Time:          time.Now(),
+		}
+	}
+
+	if len(c.errs) > 0 {
+		return checks.Result{
+			ComponentType: checks.ComponentTypeComponent,
+			Status:        checks.StatusFail,
+			Output:        errors.Join(c.errs...).Error(),
+			Time:          time.Now(),
 		}
 	}
 
 	result := checks.Result{
-		Status: checks.StatusPass,
-		Time:   time.Now(),
+		ComponentType: checks.ComponentTypeComponent,
+		Status:        checks.StatusPass,
+		Time:          time.Now(),
 	}
 
 	requestCtx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(requestCtx, "GET", c.url, nil)
+	req, err := c.newRequest(requestCtx)
 	if err != nil {
-		result.Output = "failed to create request: " + err.Error()
+		result.Output = err.Error()
 		result.Status = checks.StatusFail
 		return result
 	}
@@ -120,17 +147,30 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 	}()
 
 	duration := time.Since(startTime)
-	result.ObservedUnit = "ms"
+	result.ObservedUnit = checks.UnitMilliseconds
 	result.ObservedValue = duration.Milliseconds()
 
 	// Evaluate response status
-	if c.isExpectedStatusCode(resp.StatusCode) {
-		result.Status = checks.StatusPass
-	} else {
+	if !c.isExpectedStatusCode(resp.StatusCode) {
 		result.Status = checks.StatusFail
 		result.Output = "unexpected status code: " + resp.Status
+		return result
+	}
+
+	// Evaluate response headers and body
+	if len(c.assertions) > 0 {
+		r, err := c.readResponse(resp)
+		if err == nil {
+			err = c.assert(r)
+		}
+		if err != nil {
+			result.Status = checks.StatusFail
+			result.Output = err.Error()
+			return result
+		}
 	}
 
+	result.Status = checks.StatusPass
 	return result
 }
 
@@ -143,3 +183,45 @@ func (c *Check) isExpectedStatusCode(statusCode int) bool {
 
 	return statusCode >= 200 && statusCode < 400
 }
+
+// Validate verifies the configuration of the check: the URL must be an absolute http or https URL,
+// the method is required, the timeout must be positive, the expected status codes must be valid HTTP
+// status codes, the regular expressions of the assertions must be valid, the maximum body size must be
+// positive and body assertions cannot be used with HEAD requests.
+func (c *Check) Validate() error {
+	errs := slices.Clone(c.errs)
+
+	if c.url == "" {
+		errs = append(errs, errors.New("URL is required"))
+	} else if u, err := url.Parse(c.url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid URL %q: expected an absolute http or https URL", c.url))
+	}
+
+	if c.method == "" {
+		errs = append(errs, errors.New("method is required"))
+	}
+
+	if c.timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive, got %s", c.timeout))
+	}
+
+	for _, code := range c.exceptedStatus {
+		if code < 100 || code > 599 {
+			errs = append(errs, fmt.Errorf("invalid expected status code: %d", code))
+		}
+	}
+
+	if c.maxBodySize <= 0 {
+		errs = append(errs, fmt.Errorf("max body size must be positive, got %d", c.maxBodySize))
+	}
+
+	if c.readBody && c.method == http.MethodHead {
+		errs = append(errs, errors.New("body assertions cannot be used with HEAD requests"))
+	}
+
+	if c.client == nil {
+		errs = append(errs, errors.New("HTTP client is required"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -45,6 +45,7 @@ func TestHTTPCheck_Run(t *testing.T) {
 
 		assert.Equal(t, checks.StatusPass, result.Status)
 		assert.Equal(t, "ms", result.ObservedUnit)
+		assert.Equal(t, checks.ComponentTypeComponent, result.ComponentType)
 		assert.GreaterOrEqual(t, result.ObservedValue, int64(0))
 	})
 
@@ -205,3 +206,38 @@ func TestHTTPCheck_GetName(t *testing.T) {
 		assert.Equal(t, "http-check", check.GetName())
 	})
 }
+
+func TestHTTPCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts valid configuration", func(t *testing.T) {
+		t.Parallel()
+
+		check := httpcheck.NewCheck(httpcheck.WithURL("https://example.com/health"))
+
+		assert.NoError(t, check.Validate())
+	})
+
+	t.Run("requires url", func(t *testing.T) {
+		t.Parallel()
+
+		assert.ErrorContains(t, httpcheck.NewCheck().Validate(), "URL is required")
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL("example.com/health"),
+			httpcheck.WithTimeout(-time.Second),
+			httpcheck.WithExpectedStatus(200, 700),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, `invalid URL "example.com/health"`)
+		assert.ErrorContains(t, err, "timeout must be positive, got -1s")
+		assert.ErrorContains(t, err, "invalid expected status code: 700")
+		assert.NotContains(t, err.Error(), "status code: 200")
+	})
+}
@@ -0,0 +1,89 @@
+package httpcheck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HeaderFunc returns headers to add to each request, such as a freshly issued bearer token.
+type HeaderFunc func(ctx context.Context) (http.Header, error)
+
+// WithMethod sets the HTTP method of the request (default: GET).
+func WithMethod(method string) Option {
+	return func(c *Check) {
+		c.method = method
+	}
+}
+
+// WithHeader adds a header to the request.
+func WithHeader(name, value string) Option {
+	return func(c *Check) {
+		c.headers.Add(name, value)
+	}
+}
+
+// WithHeaderFunc adds the headers returned by fn to each request, after the headers set with WithHeader,
+// replacing them if they have the same name. The check fails if fn returns an error.
+func WithHeaderFunc(fn HeaderFunc) Option {
+	return func(c *Check) {
+		c.headerFuncs = append(c.headerFuncs, fn)
+	}
+}
+
+// WithBody sets the body of the request. Set its content type with WithHeader.
+func WithBody(body []byte) Option {
+	return func(c *Check) {
+		c.body = body
+	}
+}
+
+// WithBasicAuth sets the username and password used to authenticate the request with HTTP basic authentication.
+func WithBasicAuth(username, password string) Option {
+	return func(c *Check) {
+		c.basicAuth = &basicAuth{username: username, password: password}
+	}
+}
+
+// basicAuth holds the credentials used for HTTP basic authentication.
+type basicAuth struct {
+	username string
+	password string
+}
+
+// newRequest creates the request sent to the checked endpoint.
+func (c *Check) newRequest(ctx context.Context) (*http.Request, error) {
+	var body io.Reader
+	if c.body != nil {
+		body = bytes.NewReader(c.body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, c.method, c.url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header = c.headers.Clone()
+	for _, fn := range c.headerFuncs {
+		headers, err := fn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request headers: %w", err)
+		}
+		for name, values := range headers {
+			req.Header[http.CanonicalHeaderKey(name)] = values
+		}
+	}
+
+	// The Host header is ignored by the HTTP client, which sends req.Host instead.
+	if host := req.Header.Get("Host"); host != "" {
+		req.Host = host
+	}
+
+	if c.basicAuth != nil {
+		req.SetBasicAuth(c.basicAuth.username, c.basicAuth.password)
+	}
+
+	return req, nil
+}
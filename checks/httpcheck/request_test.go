@@ -0,0 +1,175 @@
+package httpcheck_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/httpcheck"
+)
+
+// recordedRequest holds the parts of a request received by the test server.
+type recordedRequest struct {
+	method string
+	header http.Header
+	host   string
+	body   string
+}
+
+// newRecordingServer returns a test server recording the last request it received.
+func newRecordingServer(t *testing.T) (*httptest.Server, *atomic.Pointer[recordedRequest]) {
+	t.Helper()
+
+	var last atomic.Pointer[recordedRequest]
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		last.Store(&recordedRequest{method: r.Method, header: r.Header, host: r.Host, body: string(body)})
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &last
+}
+
+func TestHTTPCheck_Request(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sends get by default", func(t *testing.T) {
+		t.Parallel()
+
+		server, last := newRecordingServer(t)
+		check := httpcheck.NewCheck(httpcheck.WithURL(server.URL))
+
+		result := check.Run(context.Background())
+
+		require.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, http.MethodGet, last.Load().method)
+		assert.Empty(t, last.Load().body)
+	})
+
+	t.Run("sends method and body", func(t *testing.T) {
+		t.Parallel()
+
+		server, last := newRecordingServer(t)
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL(server.URL),
+			httpcheck.WithMethod(http.MethodPost),
+			httpcheck.WithHeader("Content-Type", "application/json"),
+			httpcheck.WithBody([]byte(`{"probe": true}`)),
+		)
+
+		for range 2 {
+			result := check.Run(context.Background())
+
+			require.Equal(t, checks.StatusPass, result.Status)
+			assert.Equal(t, http.MethodPost, last.Load().method)
+			assert.Equal(t, "application/json", last.Load().header.Get("Content-Type"))
+			assert.Equal(t, `{"probe": true}`, last.Load().body)
+		}
+	})
+
+	t.Run("sends head requests", func(t *testing.T) {
+		t.Parallel()
+
+		server, last := newRecordingServer(t)
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL(server.URL),
+			httpcheck.WithMethod(http.MethodHead),
+		)
+
+		result := check.Run(context.Background())
+
+		require.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, http.MethodHead, last.Load().method)
+	})
+
+	t.Run("sends static and dynamic headers", func(t *testing.T) {
+		t.Parallel()
+
+		var issued atomic.Int32
+		server, last := newRecordingServer(t)
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL(server.URL),
+			httpcheck.WithHeader("X-Request-Source", "healthcheck"),
+			httpcheck.WithHeader("Authorization", "Bearer static"),
+			httpcheck.WithHeader("Host", "api.internal"),
+			httpcheck.WithHeaderFunc(func(ctx context.Context) (http.Header, error) {
+				issued.Add(1)
+				return http.Header{"authorization": {"Bearer dynamic"}}, nil
+			}),
+		)
+
+		result := check.Run(context.Background())
+
+		require.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, "healthcheck", last.Load().header.Get("X-Request-Source"))
+		assert.Equal(t, []string{"Bearer dynamic"}, last.Load().header.Values("Authorization"))
+		assert.Equal(t, "api.internal", last.Load().host)
+		assert.Equal(t, int32(1), issued.Load())
+	})
+
+	t.Run("fails when header func fails", func(t *testing.T) {
+		t.Parallel()
+
+		server, last := newRecordingServer(t)
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL(server.URL),
+			httpcheck.WithHeaderFunc(func(ctx context.Context) (http.Header, error) {
+				return nil, errors.New("token expired")
+			}),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "failed to create request headers: token expired", result.Output)
+		assert.Nil(t, last.Load())
+	})
+
+	t.Run("sends basic auth", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "probe" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL(server.URL),
+			httpcheck.WithBasicAuth("probe", "secret"),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+	})
+
+	t.Run("validate reports invalid request settings", func(t *testing.T) {
+		t.Parallel()
+
+		check := httpcheck.NewCheck(
+			httpcheck.WithURL("http://localhost/health"),
+			httpcheck.WithMethod(http.MethodHead),
+			httpcheck.WithBodyContains("ok"),
+		)
+
+		assert.EqualError(t, check.Validate(), "body assertions cannot be used with HEAD requests")
+		assert.ErrorContains(t, httpcheck.NewCheck(
+			httpcheck.WithURL("http://localhost/health"),
+			httpcheck.WithMethod(""),
+		).Validate(), "method is required")
+	})
+}
@@ -0,0 +1,42 @@
+// Package threshold provides helpers shared by the checks that compare a usage percentage against thresholds.
+package threshold
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate verifies usage thresholds and their recovery thresholds: the thresholds must be percentages
+// with the warn threshold not above the fail threshold, and the recovery thresholds, when set,
+// must be below the threshold they recover from.
+func Validate(warn, fail, warnRecovery, failRecovery float64) error {
+	var errs []error
+
+	for _, threshold := range []struct {
+		name  string
+		value float64
+	}{
+		{"warn threshold", warn},
+		{"fail threshold", fail},
+		{"warn recovery threshold", warnRecovery},
+		{"fail recovery threshold", failRecovery},
+	} {
+		if threshold.value < 0 || threshold.value > 100 {
+			errs = append(errs, fmt.Errorf("%s must be between 0 and 100, got %.1f", threshold.name, threshold.value))
+		}
+	}
+
+	if warn > fail {
+		errs = append(errs, fmt.Errorf("warn threshold %.1f is above fail threshold %.1f", warn, fail))
+	}
+
+	if warnRecovery > 0 && warnRecovery >= warn {
+		errs = append(errs, fmt.Errorf("warn recovery threshold %.1f must be below warn threshold %.1f", warnRecovery, warn))
+	}
+
+	if failRecovery > 0 && failRecovery >= fail {
+		errs = append(errs, fmt.Errorf("fail recovery threshold %.1f must be below fail threshold %.1f", failRecovery, fail))
+	}
+
+	return errors.Join(errs...)
+}
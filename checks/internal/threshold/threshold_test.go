@@ -0,0 +1,36 @@
+package threshold_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brpaz/go-healthcheck/v2/checks/internal/threshold"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts valid thresholds", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, threshold.Validate(80, 90, 0, 0))
+		assert.NoError(t, threshold.Validate(80, 90, 75, 85))
+	})
+
+	t.Run("reports every invalid threshold", func(t *testing.T) {
+		t.Parallel()
+
+		err := threshold.Validate(95, 120, 95, -1)
+
+		assert.ErrorContains(t, err, "fail threshold must be between 0 and 100, got 120.0")
+		assert.ErrorContains(t, err, "fail recovery threshold must be between 0 and 100, got -1.0")
+		assert.ErrorContains(t, err, "warn recovery threshold 95.0 must be below warn threshold 95.0")
+	})
+
+	t.Run("reports warn threshold above fail threshold", func(t *testing.T) {
+		t.Parallel()
+
+		assert.EqualError(t, threshold.Validate(95, 90, 0, 0), "warn threshold 95.0 is above fail threshold 90.0")
+	})
+}
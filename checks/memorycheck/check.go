@@ -3,10 +3,13 @@ package memorycheck
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/internal/threshold"
 )
 
 // MemoryStats represents memory statistics
@@ -22,7 +25,12 @@ type Check struct {
 	name          string
 	warnThreshold float64 // Percentage of memory usage that triggers warning
 	failThreshold float64 // Percentage of memory usage that triggers failure
+	warnRecovery  float64 // Percentage of memory usage below which a warning recovers
+	failRecovery  float64 // Percentage of memory usage below which a failure recovers
 	reader        MemoryReader
+
+	mu         sync.Mutex
+	lastStatus checks.Status
 }
 
 // Option is a functional option for configuring Check.
@@ -49,6 +57,24 @@ func WithFailThreshold(threshold float64) Option {
 	}
 }
 
+// WithWarnRecoveryThreshold sets the memory usage percentage below which a warning status recovers.
+// While the usage stays between this value and the warn threshold, the warning is held.
+// By default, the status recovers as soon as the usage drops below the warn threshold.
+func WithWarnRecoveryThreshold(threshold float64) Option {
+	return func(c *Check) {
+		c.warnRecovery = threshold
+	}
+}
+
+// WithFailRecoveryThreshold sets the memory usage percentage below which a failure status recovers.
+// While the usage stays between this value and the fail threshold, the failure is held.
+// By default, the status recovers as soon as the usage drops below the fail threshold.
+func WithFailRecoveryThreshold(threshold float64) Option {
+	return func(c *Check) {
+		c.failRecovery = threshold
+	}
+}
+
 // WithMemoryReader sets a custom memory reader (useful for testing).
 func WithMemoryReader(reader MemoryReader) Option {
 	return func(c *Check) {
@@ -80,8 +106,9 @@ func (c *Check) GetName() string {
 // Run executes the memory health check and returns the result.
 func (c *Check) Run(ctx context.Context) checks.Result {
 	result := checks.Result{
-		Status: checks.StatusPass,
-		Time:   time.Now(),
+		ComponentType: checks.ComponentTypeSystem,
+		Status:        checks.StatusPass,
+		Time:          time.Now(),
 	}
 
 	// Read memory statistics
@@ -93,7 +120,7 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 	}
 
 	result.ObservedValue = memStats.UsedPct
-	result.ObservedUnit = "%"
+	result.ObservedUnit = checks.UnitPercent
 
 	// Check thresholds
 	if memStats.UsedPct >= c.failThreshold {
@@ -108,10 +135,47 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 		result.Status = checks.StatusPass
 	}
 
+	c.applyRecovery(&result, memStats.UsedPct)
+
 	return result
 }
 
+// applyRecovery holds a previous warn or fail status while the usage has not dropped below
+// the corresponding recovery threshold, and records the reported status.
+func (c *Check) applyRecovery(result *checks.Result, usedPct float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case c.lastStatus == checks.StatusFail && result.Status != checks.StatusFail &&
+		c.failRecovery > 0 && usedPct >= c.failRecovery:
+		result.Status = checks.StatusFail
+		result.Output = fmt.Sprintf("memory usage critical: %.1f%% used, holding fail status until usage drops below %.1f%%",
+			usedPct, c.failRecovery)
+	case (c.lastStatus == checks.StatusFail || c.lastStatus == checks.StatusWarn) && result.Status == checks.StatusPass &&
+		c.warnRecovery > 0 && usedPct >= c.warnRecovery:
+		result.Status = checks.StatusWarn
+		result.Output = fmt.Sprintf("memory usage high: %.1f%% used, holding warn status until usage drops below %.1f%%",
+			usedPct, c.warnRecovery)
+	}
+
+	c.lastStatus = result.Status
+}
+
 // GetMemoryInfo returns current memory statistics
 func (c *Check) GetMemoryInfo() (*MemoryStats, error) {
 	return c.reader.ReadMemoryStats()
 }
+
+// Validate verifies the configuration of the check: the thresholds must be percentages with the warn
+// threshold not above the fail threshold, and the recovery thresholds, when set, must be below the
+// threshold they recover from. A memory reader is required.
+func (c *Check) Validate() error {
+	errs := []error{threshold.Validate(c.warnThreshold, c.failThreshold, c.warnRecovery, c.failRecovery)}
+
+	if c.reader == nil {
+		errs = append(errs, errors.New("memory reader is required"))
+	}
+
+	return errors.Join(errs...)
+}
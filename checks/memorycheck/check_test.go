@@ -35,6 +35,7 @@ func TestMemoryCheck_Run(t *testing.T) {
 
 		assert.Equal(t, checks.StatusPass, result.Status)
 		assert.Equal(t, "%", result.ObservedUnit)
+		assert.Equal(t, checks.ComponentTypeSystem, result.ComponentType)
 		assert.GreaterOrEqual(t, result.ObservedValue, 0.0)
 		assert.LessOrEqual(t, result.ObservedValue, 100.0)
 	})
@@ -147,3 +148,101 @@ func TestMemoryCheck_ThresholdLogic(t *testing.T) {
 		assert.Equal(t, checks.StatusPass, result.Status)
 	})
 }
+
+func TestMemoryCheck_RecoveryThresholds(t *testing.T) {
+	t.Parallel()
+
+	t.Run("holds warn status until usage drops below recovery threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockReader := &MockMemoryReader{}
+		check := memorycheck.NewCheck(
+			memorycheck.WithWarnThreshold(80.0),
+			memorycheck.WithWarnRecoveryThreshold(75.0),
+			memorycheck.WithMemoryReader(mockReader),
+		)
+
+		mockReader.UsedPct = 81.0
+		assert.Equal(t, checks.StatusWarn, check.Run(context.Background()).Status)
+
+		mockReader.UsedPct = 78.0
+		result := check.Run(context.Background())
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, "memory usage high: 78.0% used, holding warn status until usage drops below 75.0%", result.Output)
+
+		mockReader.UsedPct = 74.0
+		assert.Equal(t, checks.StatusPass, check.Run(context.Background()).Status)
+	})
+
+	t.Run("holds fail status until usage drops below recovery threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockReader := &MockMemoryReader{}
+		check := memorycheck.NewCheck(
+			memorycheck.WithWarnThreshold(80.0),
+			memorycheck.WithFailThreshold(90.0),
+			memorycheck.WithFailRecoveryThreshold(85.0),
+			memorycheck.WithMemoryReader(mockReader),
+		)
+
+		mockReader.UsedPct = 91.0
+		assert.Equal(t, checks.StatusFail, check.Run(context.Background()).Status)
+
+		mockReader.UsedPct = 87.0
+		result := check.Run(context.Background())
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Contains(t, result.Output, "holding fail status")
+
+		mockReader.UsedPct = 84.0
+		assert.Equal(t, checks.StatusWarn, check.Run(context.Background()).Status)
+	})
+
+	t.Run("recovers immediately without recovery thresholds", func(t *testing.T) {
+		t.Parallel()
+
+		mockReader := &MockMemoryReader{}
+		check := memorycheck.NewCheck(
+			memorycheck.WithWarnThreshold(80.0),
+			memorycheck.WithMemoryReader(mockReader),
+		)
+
+		mockReader.UsedPct = 81.0
+		assert.Equal(t, checks.StatusWarn, check.Run(context.Background()).Status)
+
+		mockReader.UsedPct = 79.0
+		assert.Equal(t, checks.StatusPass, check.Run(context.Background()).Status)
+	})
+}
+
+func TestMemoryCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts default configuration", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, memorycheck.NewCheck().Validate())
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		check := memorycheck.NewCheck(
+			memorycheck.WithWarnThreshold(-5),
+			memorycheck.WithFailThreshold(60),
+			memorycheck.WithFailRecoveryThreshold(70),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, "warn threshold must be between 0 and 100, got -5.0")
+		assert.ErrorContains(t, err, "fail recovery threshold 70.0 must be below fail threshold 60.0")
+	})
+
+	t.Run("rejects warn threshold above fail threshold", func(t *testing.T) {
+		t.Parallel()
+
+		check := memorycheck.NewCheck(memorycheck.WithWarnThreshold(98))
+
+		assert.EqualError(t, check.Validate(), "warn threshold 98.0 is above fail threshold 95.0")
+	})
+}
@@ -2,6 +2,7 @@ package mockcheck
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/brpaz/go-healthcheck/v2/checks"
@@ -9,9 +10,20 @@ import (
 
 // Check is a mock implementation of the Check interface for testing purposes.
 // It returns a single check result with the specified result status.
+// It can also return a sequence of statuses or results, simulate slow checks,
+// panic, and report how many times it was run.
 type Check struct {
-	name   string
-	status checks.Status
+	name    string
+	status  checks.Status
+	output  string
+	results []checks.Result
+	delay   time.Duration
+	hook    func(ctx context.Context)
+	panics  any
+
+	mu      sync.Mutex
+	runs    int
+	lastRun time.Time
 }
 
 // Option is a functional option for configuring the MockCheck.
@@ -31,6 +43,55 @@ func WithStatus(status checks.Status) Option {
 	}
 }
 
+// WithStatuses makes successive runs return the given statuses in order,
+// starting over after the last one.
+func WithStatuses(statuses ...checks.Status) Option {
+	return func(c *Check) {
+		c.results = nil
+		for _, status := range statuses {
+			c.results = append(c.results, checks.Result{Status: status})
+		}
+	}
+}
+
+// WithResults makes successive runs return the given results in order,
+// starting over after the last one. Results without a time are reported with the time of the run.
+func WithResults(results ...checks.Result) Option {
+	return func(c *Check) {
+		c.results = results
+	}
+}
+
+// WithOutput sets the output of the results that are not passing (default: "check failed" for fail).
+func WithOutput(output string) Option {
+	return func(c *Check) {
+		c.output = output
+	}
+}
+
+// WithDelay makes every run take the given time, ignoring the context,
+// to simulate checks that do not honour cancellation.
+func WithDelay(delay time.Duration) Option {
+	return func(c *Check) {
+		c.delay = delay
+	}
+}
+
+// WithHook calls the hook at every run, before the result is returned.
+// The hook can block to simulate work, for example to track how many checks run at once.
+func WithHook(hook func(ctx context.Context)) Option {
+	return func(c *Check) {
+		c.hook = hook
+	}
+}
+
+// WithPanic makes every run panic with the given value.
+func WithPanic(value any) Option {
+	return func(c *Check) {
+		c.panics = value
+	}
+}
+
 // NewCheck creates a new MockCheck instance with optional configuration.
 func NewCheck(opts ...Option) *Check {
 	m := &Check{
@@ -48,17 +109,55 @@ func (c *Check) GetName() string {
 	return c.name
 }
 
-// Execute runs the mock check and returns a single check result based on the configured status.
+// Runs returns the number of times the check was run.
+func (c *Check) Runs() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.runs
+}
+
+// LastRun returns the time at which the check was last run, or the zero time if it never ran.
+func (c *Check) LastRun() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lastRun
+}
+
+// Run runs the mock check and returns a single check result based on the configured status.
 func (c *Check) Run(ctx context.Context) checks.Result {
-	var output string
-	if c.status == checks.StatusFail {
-		output = "check failed"
+	c.mu.Lock()
+	run := c.runs
+	c.runs++
+	c.lastRun = time.Now()
+	c.mu.Unlock()
+
+	if c.panics != nil {
+		panic(c.panics)
+	}
+	if c.hook != nil {
+		c.hook(ctx)
+	}
+	if c.delay > 0 {
+		time.Sleep(c.delay)
+	}
+
+	result := checks.Result{Status: c.status}
+	if len(c.results) > 0 {
+		result = c.results[run%len(c.results)]
 	}
 
-	result := checks.Result{
-		Status: c.status,
-		Output: output,
-		Time:   time.Now(),
+	if result.Output == "" && result.Status != checks.StatusPass {
+		switch {
+		case c.output != "":
+			result.Output = c.output
+		case result.Status == checks.StatusFail:
+			result.Output = "check failed"
+		}
+	}
+	if result.Time.IsZero() {
+		result.Time = time.Now()
 	}
 	return result
 }
@@ -3,6 +3,7 @@ package mockcheck_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -53,3 +54,51 @@ func TestMockCheck_Execute(t *testing.T) {
 		assert.Equal(t, "fail", check.GetName())
 	})
 }
+
+func TestMockCheck_Sequences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cycles through statuses", func(t *testing.T) {
+		t.Parallel()
+		check := mockcheck.NewCheck(
+			mockcheck.WithStatuses(checks.StatusFail, checks.StatusWarn, checks.StatusPass),
+			mockcheck.WithOutput("connection refused"),
+		)
+
+		var statuses []checks.Status
+		for range 4 {
+			statuses = append(statuses, check.Run(context.Background()).Status)
+		}
+
+		assert.Equal(t, []checks.Status{checks.StatusFail, checks.StatusWarn, checks.StatusPass, checks.StatusFail}, statuses)
+		assert.Equal(t, 4, check.Runs())
+		assert.Equal(t, "connection refused", check.Run(context.Background()).Output)
+	})
+
+	t.Run("returns results", func(t *testing.T) {
+		t.Parallel()
+		check := mockcheck.NewCheck(mockcheck.WithResults(
+			checks.Result{Status: checks.StatusWarn, ObservedValue: 85.5},
+		))
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, 85.5, result.ObservedValue)
+		assert.False(t, result.Time.IsZero())
+		assert.WithinDuration(t, result.Time, check.LastRun(), time.Second)
+	})
+
+	t.Run("delays and panics", func(t *testing.T) {
+		t.Parallel()
+		slow := mockcheck.NewCheck(mockcheck.WithDelay(10 * time.Millisecond))
+
+		start := time.Now()
+		slow.Run(context.Background())
+
+		assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+		assert.PanicsWithValue(t, "boom", func() {
+			mockcheck.NewCheck(mockcheck.WithPanic("boom")).Run(context.Background())
+		})
+	})
+}
@@ -4,6 +4,8 @@ package redischeck
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/brpaz/go-healthcheck/v2/checks"
@@ -75,15 +77,17 @@ func (c *Check) GetName() string {
 func (c *Check) Run(ctx context.Context) checks.Result {
 	if c.client == nil {
 		return checks.Result{
-			Status: checks.StatusFail,
-			Output: "Redis client is required",
-			Time:   time.Now(),
+			ComponentType: checks.ComponentTypeDatastore,
+			Status:        checks.StatusFail,
+			Output:        "Redis client is required",
+			Time:          time.Now(),
 		}
 	}
 
 	result := checks.Result{
-		Status: checks.StatusPass,
-		Time:   time.Now(),
+		ComponentType: checks.ComponentTypeDatastore,
+		Status:        checks.StatusPass,
+		Time:          time.Now(),
 	}
 
 	// Create timeout context for Redis operations
@@ -100,8 +104,23 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 	}
 
 	duration := time.Since(startTime)
-	result.ObservedUnit = "ms"
+	result.ObservedUnit = checks.UnitMilliseconds
 	result.ObservedValue = duration.Milliseconds()
 
 	return result
 }
+
+// Validate verifies the configuration of the check: the Redis client is required and the timeout must be positive.
+func (c *Check) Validate() error {
+	var errs []error
+
+	if c.client == nil {
+		errs = append(errs, errors.New("Redis client is required"))
+	}
+
+	if c.timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive, got %s", c.timeout))
+	}
+
+	return errors.Join(errs...)
+}
@@ -88,6 +88,7 @@ func TestRedisCheck_Run(t *testing.T) {
 
 		assert.Equal(t, checks.StatusPass, result.Status)
 		assert.Equal(t, "ms", result.ObservedUnit)
+		assert.Equal(t, checks.ComponentTypeDatastore, result.ComponentType)
 		assert.GreaterOrEqual(t, result.ObservedValue, int64(0))
 
 		mockClient.AssertExpectations(t)
@@ -148,3 +149,24 @@ func TestRedisCheck_Run(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 }
+
+func TestRedisCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts valid configuration", func(t *testing.T) {
+		t.Parallel()
+
+		check := redischeck.NewCheck(redischeck.WithClient(&MockRedisClient{}))
+
+		assert.NoError(t, check.Validate())
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		err := redischeck.NewCheck(redischeck.WithTimeout(0)).Validate()
+
+		assert.ErrorContains(t, err, "Redis client is required")
+		assert.ErrorContains(t, err, "timeout must be positive, got 0s")
+	})
+}
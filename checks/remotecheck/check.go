@@ -0,0 +1,226 @@
+// Package remotecheck provides a check that aggregates the health of a downstream service.
+// It calls the application/health+json endpoint of the service and reports its overall status,
+// optionally including the results of the remote checks.
+package remotecheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+const defaultTimeout = 5 * time.Second
+
+// maxBodySize limits the size of the response body read from the remote health endpoint.
+const maxBodySize = 10 << 20
+
+// Check represents a check that reports the health of a remote service.
+type Check struct {
+	name          string
+	url           string
+	timeout       time.Duration
+	client        *http.Client
+	headers       http.Header
+	statusMapping map[checks.Status]checks.Status
+	nestedChecks  bool
+}
+
+// Option is a functional option for configuring Check.
+type Option func(*Check)
+
+// WithName sets the name of the check.
+func WithName(name string) Option {
+	return func(c *Check) {
+		c.name = name
+	}
+}
+
+// WithURL sets the url of the remote health endpoint.
+func WithURL(url string) Option {
+	return func(c *Check) {
+		c.url = url
+	}
+}
+
+// WithTimeout sets the timeout of the check.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Check) {
+		c.timeout = timeout
+	}
+}
+
+// WithHTTPClient specifies a custom HTTP client to use for the health check.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Check) {
+		c.client = client
+	}
+}
+
+// WithHeader adds a header to the request sent to the remote health endpoint.
+func WithHeader(name, value string) Option {
+	return func(c *Check) {
+		c.headers.Add(name, value)
+	}
+}
+
+// WithStatusMapping reports the remote status with the given local status.
+// For example, WithStatusMapping(checks.StatusFail, checks.StatusWarn) makes a failing remote service
+// degrade this service instead of failing it. By default, the remote status is reported unchanged.
+func WithStatusMapping(remote, local checks.Status) Option {
+	return func(c *Check) {
+		c.statusMapping[remote] = local
+	}
+}
+
+// WithNestedChecks includes the results of the remote checks in the nested checks of the result.
+func WithNestedChecks() Option {
+	return func(c *Check) {
+		c.nestedChecks = true
+	}
+}
+
+// NewCheck creates a new remote Check instance with optional configuration.
+func NewCheck(opts ...Option) *Check {
+	check := &Check{
+		name:          "remote-check",
+		timeout:       defaultTimeout,
+		client:        http.DefaultClient,
+		headers:       make(http.Header),
+		statusMapping: make(map[checks.Status]checks.Status),
+	}
+
+	for _, opt := range opts {
+		opt(check)
+	}
+
+	return check
+}
+
+// GetName returns the name of the check.
+func (c *Check) GetName() string {
+	return c.name
+}
+
+// Run calls the remote health endpoint and returns its overall status as the result.
+// Unreachable endpoints and responses that are not health responses are reported as fail.
+func (c *Check) Run(ctx context.Context) checks.Result {
+	result := checks.Result{
+		ComponentType: checks.ComponentTypeComponent,
+		Status:        checks.StatusFail,
+		Time:          time.Now(),
+	}
+
+	if c.url == "" {
+		result.Output = "URL is required for remote health check"
+		return result
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodGet, c.url, nil)
+	if err != nil {
+		result.Output = "failed to create request: " + err.Error()
+		return result
+	}
+	req.Header = c.headers.Clone()
+	req.Header.Set("Accept", "application/health+json, application/json")
+
+	startTime := time.Now()
+	resp, err := c.client.Do(req)
+	if err != nil {
+		result.Output = "failed to execute request: " + err.Error()
+		return result
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	remote, err := decode(resp)
+
+	result.ObservedUnit = checks.UnitMilliseconds
+	result.ObservedValue = time.Since(startTime).Milliseconds()
+
+	if err != nil {
+		result.Output = err.Error()
+		return result
+	}
+
+	result.ComponentID = remote.ServiceID
+	result.Output = remote.Output
+	result.Status = c.mapStatus(remote.Status)
+
+	switch remote.Status {
+	case checks.StatusPass, checks.StatusWarn, checks.StatusFail:
+	default:
+		if _, ok := c.statusMapping[remote.Status]; !ok {
+			result.Output = fmt.Sprintf("unknown remote status %q", remote.Status)
+		}
+	}
+
+	if c.nestedChecks && len(remote.Checks) > 0 {
+		result.Checks = remote.Checks
+	}
+
+	return result
+}
+
+// decode parses the health response of the remote service.
+func decode(resp *http.Response) (healthcheck.HealthHttpResponse, error) {
+	var remote healthcheck.HealthHttpResponse
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return remote, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if err := json.Unmarshal(body, &remote); err != nil || remote.Status == "" {
+		return remote, fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	return remote, nil
+}
+
+// mapStatus returns the local status of the given remote status.
+func (c *Check) mapStatus(remote checks.Status) checks.Status {
+	if local, ok := c.statusMapping[remote]; ok {
+		return local
+	}
+
+	switch remote {
+	case checks.StatusPass, checks.StatusWarn:
+		return remote
+	default:
+		return checks.StatusFail
+	}
+}
+
+// Validate verifies the configuration of the check: the URL must be an absolute http or https URL
+// and the timeout must be positive.
+func (c *Check) Validate() error {
+	var errs []error
+
+	if c.url == "" {
+		errs = append(errs, errors.New("URL is required"))
+	} else if u, err := url.Parse(c.url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid URL %q: expected an absolute http or https URL", c.url))
+	}
+
+	if c.timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive, got %s", c.timeout))
+	}
+
+	if c.client == nil {
+		errs = append(errs, errors.New("HTTP client is required"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,198 @@
+package remotecheck_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/remotecheck"
+)
+
+func newRemoteServer(t *testing.T, code int, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/health+json")
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRemoteCheck_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports the remote status", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRemoteServer(t, http.StatusOK, `{"serviceId":"orders","status":"warn","output":"disk: disk usage high","checks":{}}`)
+		check := remotecheck.NewCheck(
+			remotecheck.WithName("remote:orders"),
+			remotecheck.WithURL(server.URL),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, "remote:orders", check.GetName())
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, "disk: disk usage high", result.Output)
+		assert.Equal(t, "orders", result.ComponentID)
+		assert.Equal(t, checks.ComponentTypeComponent, result.ComponentType)
+		assert.Equal(t, checks.UnitMilliseconds, result.ObservedUnit)
+		assert.Empty(t, result.Checks)
+	})
+
+	t.Run("reads failing responses", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRemoteServer(t, http.StatusServiceUnavailable, `{"status":"fail","output":"db: connection refused","checks":{}}`)
+		check := remotecheck.NewCheck(remotecheck.WithURL(server.URL))
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "db: connection refused", result.Output)
+	})
+
+	t.Run("maps the remote status", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRemoteServer(t, http.StatusServiceUnavailable, `{"status":"fail","checks":{}}`)
+		check := remotecheck.NewCheck(
+			remotecheck.WithURL(server.URL),
+			remotecheck.WithStatusMapping(checks.StatusFail, checks.StatusWarn),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+	})
+
+	t.Run("includes nested checks", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRemoteServer(t, http.StatusOK, `{"status":"pass","checks":{
+			"db":[{"status":"pass","componentType":"datastore","observedValue":12}],
+			"cache":[{"status":"pass"}]
+		}}`)
+		check := remotecheck.NewCheck(
+			remotecheck.WithURL(server.URL),
+			remotecheck.WithNestedChecks(),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		require.Len(t, result.Checks, 2)
+		assert.Equal(t, checks.ComponentTypeDatastore, result.Checks["db"][0].ComponentType)
+		assert.InDelta(t, 12, result.Checks["db"][0].ObservedValue, 0)
+	})
+
+	t.Run("sends headers", func(t *testing.T) {
+		t.Parallel()
+
+		var received http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r.Header.Clone()
+			_, _ = w.Write([]byte(`{"status":"pass"}`))
+		}))
+		defer server.Close()
+
+		check := remotecheck.NewCheck(
+			remotecheck.WithURL(server.URL),
+			remotecheck.WithHeader("Authorization", "Bearer token"),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, "Bearer token", received.Get("Authorization"))
+		assert.Contains(t, received.Get("Accept"), "application/health+json")
+	})
+
+	t.Run("fails on responses that are not health responses", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRemoteServer(t, http.StatusBadGateway, `<html>bad gateway</html>`)
+		check := remotecheck.NewCheck(remotecheck.WithURL(server.URL))
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "unexpected response: 502 Bad Gateway", result.Output)
+	})
+
+	t.Run("fails on unknown remote status", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRemoteServer(t, http.StatusOK, `{"status":"degraded"}`)
+		check := remotecheck.NewCheck(remotecheck.WithURL(server.URL))
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, `unknown remote status "degraded"`, result.Output)
+	})
+
+	t.Run("fails on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		check := remotecheck.NewCheck(
+			remotecheck.WithURL(server.URL),
+			remotecheck.WithTimeout(20*time.Millisecond),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Contains(t, result.Output, "failed to execute request")
+	})
+
+	t.Run("fails without url", func(t *testing.T) {
+		t.Parallel()
+
+		result := remotecheck.NewCheck().Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "URL is required for remote health check", result.Output)
+	})
+}
+
+func TestRemoteCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts valid configuration", func(t *testing.T) {
+		t.Parallel()
+
+		check := remotecheck.NewCheck(remotecheck.WithURL("http://payments/health"))
+
+		assert.NoError(t, check.Validate())
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		check := remotecheck.NewCheck(
+			remotecheck.WithURL("ftp://payments/health"),
+			remotecheck.WithTimeout(0),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, `invalid URL "ftp://payments/health"`)
+		assert.ErrorContains(t, err, "timeout must be positive, got 0s")
+	})
+}
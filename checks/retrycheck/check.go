@@ -0,0 +1,209 @@
+// Package retrycheck provides a check decorator that retries transient failures.
+// It runs the wrapped check again, with backoff, until it passes or the attempts or time budget are exhausted.
+package retrycheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultBackoff     = 100 * time.Millisecond
+	defaultMultiplier  = 2.0
+)
+
+// Check wraps another check and retries it when it fails.
+type Check struct {
+	name        string
+	check       checks.Check
+	maxAttempts int
+	backoff     time.Duration
+	multiplier  float64
+	maxBackoff  time.Duration
+	budget      time.Duration
+}
+
+// Option is a functional option for configuring Check.
+type Option func(*Check)
+
+// WithName sets the name of the check. By default, the name of the wrapped check is used.
+func WithName(name string) Option {
+	return func(c *Check) {
+		c.name = name
+	}
+}
+
+// WithCheck sets the check to wrap.
+func WithCheck(check checks.Check) Option {
+	return func(c *Check) {
+		c.check = check
+	}
+}
+
+// WithMaxAttempts sets the maximum number of times the check is attempted, including the first one (default: 3).
+func WithMaxAttempts(attempts int) Option {
+	return func(c *Check) {
+		c.maxAttempts = attempts
+	}
+}
+
+// WithBackoff sets the time to wait before the first retry (default: 100ms).
+func WithBackoff(backoff time.Duration) Option {
+	return func(c *Check) {
+		c.backoff = backoff
+	}
+}
+
+// WithBackoffMultiplier sets the factor applied to the backoff after every retry (default: 2).
+// Use 1 for a constant backoff.
+func WithBackoffMultiplier(multiplier float64) Option {
+	return func(c *Check) {
+		c.multiplier = multiplier
+	}
+}
+
+// WithMaxBackoff caps the time to wait between two attempts.
+func WithMaxBackoff(maxBackoff time.Duration) Option {
+	return func(c *Check) {
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithBudget sets the total time allowed for all the attempts, including the backoff between them.
+// No retry is started when the backoff would exceed the remaining budget.
+func WithBudget(budget time.Duration) Option {
+	return func(c *Check) {
+		c.budget = budget
+	}
+}
+
+// NewCheck creates a new retry Check instance with optional configuration.
+func NewCheck(opts ...Option) *Check {
+	check := &Check{
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+		multiplier:  defaultMultiplier,
+	}
+
+	for _, opt := range opts {
+		opt(check)
+	}
+
+	return check
+}
+
+// GetName returns the name of the check.
+func (c *Check) GetName() string {
+	if c.name == "" && c.check != nil {
+		return c.check.GetName()
+	}
+	return c.name
+}
+
+// Run executes the wrapped check until it does not fail or the attempts or time budget are exhausted.
+// The returned result is the one of the last attempt, with the number of attempts recorded.
+func (c *Check) Run(ctx context.Context) checks.Result {
+	if c.check == nil {
+		return checks.Result{
+			Status: checks.StatusFail,
+			Output: "check to wrap is required",
+			Time:   time.Now(),
+		}
+	}
+
+	if c.budget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.budget)
+		defer cancel()
+	}
+
+	backoff := c.backoff
+	attempts := 0
+
+	for {
+		attempts++
+		result := c.check.Run(ctx)
+		result.Attempts = attempts
+
+		if result.Status != checks.StatusFail || attempts >= c.maxAttempts {
+			return result
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= backoff {
+			result.Output = appendOutput(result.Output, fmt.Sprintf("retry budget exhausted after %d attempts", attempts))
+			return result
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			result.Output = appendOutput(result.Output, fmt.Sprintf("retry cancelled after %d attempts", attempts))
+			return result
+		case <-timer.C:
+		}
+
+		backoff = c.nextBackoff(backoff)
+	}
+}
+
+// nextBackoff returns the time to wait before the attempt following the given backoff.
+func (c *Check) nextBackoff(backoff time.Duration) time.Duration {
+	if c.multiplier > 0 {
+		backoff = time.Duration(float64(backoff) * c.multiplier)
+	}
+	if c.maxBackoff > 0 && backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+	return backoff
+}
+
+// appendOutput appends a note to the output of a result.
+func appendOutput(output, note string) string {
+	if output == "" {
+		return note
+	}
+	return output + " (" + note + ")"
+}
+
+// Validate verifies the configuration of the check: the check to wrap is required, at least one attempt
+// must be allowed, the backoff settings must not be negative or shrink the backoff, and the configuration
+// of the wrapped check must be valid.
+func (c *Check) Validate() error {
+	if c.check == nil {
+		return errors.New("check to wrap is required")
+	}
+
+	var errs []error
+
+	if c.maxAttempts < 1 {
+		errs = append(errs, fmt.Errorf("max attempts must be at least 1, got %d", c.maxAttempts))
+	}
+
+	if c.backoff < 0 {
+		errs = append(errs, fmt.Errorf("backoff must not be negative, got %s", c.backoff))
+	}
+
+	if c.multiplier < 1 {
+		errs = append(errs, fmt.Errorf("backoff multiplier must be at least 1, got %g", c.multiplier))
+	}
+
+	if c.maxBackoff < 0 {
+		errs = append(errs, fmt.Errorf("max backoff must not be negative, got %s", c.maxBackoff))
+	}
+
+	if c.budget < 0 {
+		errs = append(errs, fmt.Errorf("budget must not be negative, got %s", c.budget))
+	}
+
+	if err := checks.Validate(c.check); err != nil {
+		errs = append(errs, fmt.Errorf("check %q: %w", c.check.GetName(), err))
+	}
+
+	return errors.Join(errs...)
+}
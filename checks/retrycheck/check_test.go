@@ -0,0 +1,178 @@
+package retrycheck_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/diskcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/retrycheck"
+)
+
+// newFlakyCheck returns a mock check that fails the given number of times before passing.
+func newFlakyCheck(failures int) *mockcheck.Check {
+	statuses := make([]checks.Status, 0, failures+1)
+	for range failures {
+		statuses = append(statuses, checks.StatusFail)
+	}
+	return mockcheck.NewCheck(
+		mockcheck.WithName("flaky"),
+		mockcheck.WithStatuses(append(statuses, checks.StatusPass)...),
+		mockcheck.WithOutput("connection refused"),
+	)
+}
+
+func TestRetryCheck_New(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uses name of the wrapped check by default", func(t *testing.T) {
+		t.Parallel()
+
+		check := retrycheck.NewCheck(retrycheck.WithCheck(newFlakyCheck(0)))
+		assert.Equal(t, "flaky", check.GetName())
+	})
+
+	t.Run("uses custom name", func(t *testing.T) {
+		t.Parallel()
+
+		check := retrycheck.NewCheck(
+			retrycheck.WithName("retried"),
+			retrycheck.WithCheck(newFlakyCheck(0)),
+		)
+		assert.Equal(t, "retried", check.GetName())
+	})
+}
+
+func TestRetryCheck_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fails without wrapped check", func(t *testing.T) {
+		t.Parallel()
+
+		result := retrycheck.NewCheck().Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "check to wrap is required", result.Output)
+	})
+
+	t.Run("passes on first attempt", func(t *testing.T) {
+		t.Parallel()
+
+		flaky := newFlakyCheck(0)
+		check := retrycheck.NewCheck(retrycheck.WithCheck(flaky))
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, 1, result.Attempts)
+		assert.Equal(t, 1, flaky.Runs())
+	})
+
+	t.Run("passes after transient failure", func(t *testing.T) {
+		t.Parallel()
+
+		flaky := newFlakyCheck(1)
+		check := retrycheck.NewCheck(
+			retrycheck.WithCheck(flaky),
+			retrycheck.WithBackoff(time.Millisecond),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, 2, result.Attempts)
+	})
+
+	t.Run("fails when attempts are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		flaky := newFlakyCheck(10)
+		check := retrycheck.NewCheck(
+			retrycheck.WithCheck(flaky),
+			retrycheck.WithMaxAttempts(3),
+			retrycheck.WithBackoff(time.Millisecond),
+		)
+
+		result := check.Run(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "connection refused", result.Output)
+		assert.Equal(t, 3, result.Attempts)
+		assert.Equal(t, 3, flaky.Runs())
+	})
+
+	t.Run("stops retrying when budget is exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		flaky := newFlakyCheck(10)
+		check := retrycheck.NewCheck(
+			retrycheck.WithCheck(flaky),
+			retrycheck.WithMaxAttempts(10),
+			retrycheck.WithBackoff(20*time.Millisecond),
+			retrycheck.WithBackoffMultiplier(1),
+			retrycheck.WithBudget(50*time.Millisecond),
+		)
+
+		start := time.Now()
+		result := check.Run(context.Background())
+
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Less(t, result.Attempts, 10)
+		assert.Contains(t, result.Output, "retry budget exhausted")
+	})
+
+	t.Run("stops retrying when context is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		flaky := newFlakyCheck(10)
+		check := retrycheck.NewCheck(
+			retrycheck.WithCheck(flaky),
+			retrycheck.WithBackoff(time.Hour),
+		)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		result := check.Run(ctx)
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, 1, result.Attempts)
+		assert.Contains(t, result.Output, "retry cancelled after 1 attempts")
+	})
+}
+
+func TestRetryCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requires wrapped check", func(t *testing.T) {
+		t.Parallel()
+
+		assert.EqualError(t, retrycheck.NewCheck().Validate(), "check to wrap is required")
+	})
+
+	t.Run("reports invalid settings and wrapped check", func(t *testing.T) {
+		t.Parallel()
+
+		check := retrycheck.NewCheck(
+			retrycheck.WithCheck(diskcheck.NewCheck(diskcheck.WithPath(""))),
+			retrycheck.WithMaxAttempts(0),
+			retrycheck.WithBackoffMultiplier(0.5),
+			retrycheck.WithBudget(-time.Second),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, "max attempts must be at least 1, got 0")
+		assert.ErrorContains(t, err, "backoff multiplier must be at least 1, got 0.5")
+		assert.ErrorContains(t, err, "budget must not be negative, got -1s")
+		assert.ErrorContains(t, err, `check "disk-check": path is required`)
+	})
+}
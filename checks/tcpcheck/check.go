@@ -4,6 +4,7 @@ package tcpcheck
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
@@ -118,8 +119,9 @@ func (c *Check) GetName() string {
 // Run executes the TCP/UDP health check and returns the result.
 func (c *Check) Run(ctx context.Context) checks.Result {
 	result := checks.Result{
-		Status: checks.StatusPass,
-		Time:   time.Now(),
+		ComponentType: checks.ComponentTypeComponent,
+		Status:        checks.StatusPass,
+		Time:          time.Now(),
 	}
 
 	// Validate configuration
@@ -157,7 +159,7 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 	}
 
 	duration := time.Since(startTime)
-	result.ObservedUnit = "ms"
+	result.ObservedUnit = checks.UnitMilliseconds
 	result.ObservedValue = duration.Milliseconds()
 
 	return result
@@ -167,3 +169,27 @@ func (c *Check) Run(ctx context.Context) checks.Result {
 func (c *Check) Address() string {
 	return fmt.Sprintf("%s://%s:%d", c.network, c.host, c.port)
 }
+
+// Validate verifies the configuration of the check: the host is required, the port must be between 1 and 65535,
+// the network must be tcp or udp and the timeout must be positive.
+func (c *Check) Validate() error {
+	var errs []error
+
+	if c.host == "" {
+		errs = append(errs, errors.New("host is required"))
+	}
+
+	if c.port <= 0 || c.port > 65535 {
+		errs = append(errs, fmt.Errorf("port must be between 1 and 65535, got %d", c.port))
+	}
+
+	if c.network != TCP && c.network != UDP {
+		errs = append(errs, fmt.Errorf("network must be %q or %q, got %q", TCP, UDP, c.network))
+	}
+
+	if c.timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive, got %s", c.timeout))
+	}
+
+	return errors.Join(errs...)
+}
@@ -195,6 +195,7 @@ func TestTCPCheck_Run(t *testing.T) {
 
 		assert.Equal(t, checks.StatusPass, result.Status)
 		assert.Equal(t, "ms", result.ObservedUnit)
+		assert.Equal(t, checks.ComponentTypeComponent, result.ComponentType)
 		assert.GreaterOrEqual(t, result.ObservedValue, int64(0))
 
 		mockDialer.AssertExpectations(t)
@@ -357,3 +358,31 @@ func TestTCPCheck_Options(t *testing.T) {
 		mockDialer.AssertExpectations(t)
 	})
 }
+
+func TestTCPCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts valid configuration", func(t *testing.T) {
+		t.Parallel()
+
+		check := tcpcheck.NewCheck(tcpcheck.WithHost("localhost"), tcpcheck.WithPort(5432))
+
+		assert.NoError(t, check.Validate())
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		t.Parallel()
+
+		check := tcpcheck.NewCheck(
+			tcpcheck.WithNetwork("icmp"),
+			tcpcheck.WithTimeout(0),
+		)
+
+		err := check.Validate()
+
+		assert.ErrorContains(t, err, "host is required")
+		assert.ErrorContains(t, err, "port must be between 1 and 65535, got 0")
+		assert.ErrorContains(t, err, `network must be "tcp" or "udp", got "icmp"`)
+		assert.ErrorContains(t, err, "timeout must be positive, got 0s")
+	})
+}
@@ -0,0 +1,215 @@
+// Command healthprobe calls a health endpoint and exits with a status code derived from the reported status.
+// It is meant to be used as a Docker HEALTHCHECK in images without curl, such as distroless images.
+//
+// Usage:
+//
+//	healthprobe [flags] [url]
+//
+// By default, healthprobe exits with 0 for pass, 1 for warn and 2 for fail. Errors reaching the endpoint,
+// or reading its response, are reported as fail.
+//
+// Docker only accepts 0 (healthy) and 1 (unhealthy) as exit codes, so remap them in a Docker HEALTHCHECK:
+//
+//	HEALTHCHECK --interval=30s --timeout=5s CMD ["/healthprobe", "-timeout=3s", "-exit-warn=0", "-exit-fail=1", "http://localhost:8080/health"]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+const defaultURL = "http://localhost:8080/health"
+
+// maxBodySize limits the size of the response body read from the health endpoint.
+const maxBodySize = 10 << 20
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// headers collects the repeatable -header flag values.
+type headers []string
+
+func (h *headers) String() string {
+	return strings.Join(*h, ", ")
+}
+
+func (h *headers) Set(value string) error {
+	name, _, ok := strings.Cut(value, ":")
+	if !ok || strings.TrimSpace(name) == "" {
+		return fmt.Errorf("invalid header %q: expected \"Name: value\"", value)
+	}
+	*h = append(*h, value)
+	return nil
+}
+
+// config holds the command-line options.
+type config struct {
+	url        string
+	timeout    time.Duration
+	headers    headers
+	unixSocket string
+	quiet      bool
+	verbose    bool
+	exitCodes  map[checks.Status]int
+}
+
+// run executes the probe with the given arguments and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	var cfg config
+	var exitPass, exitWarn, exitFail int
+
+	fs := flag.NewFlagSet("healthprobe", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: healthprobe [flags] [url]")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&cfg.url, "url", defaultURL, "URL of the health endpoint; can also be given as an argument")
+	fs.DurationVar(&cfg.timeout, "timeout", 5*time.Second, "maximum time to wait for the response")
+	fs.Var(&cfg.headers, "header", "request header as \"Name: value\"; can be repeated")
+	fs.StringVar(&cfg.unixSocket, "unix-socket", "", "path of a unix socket to connect to instead of the URL host")
+	fs.BoolVar(&cfg.quiet, "quiet", false, "do not print anything")
+	fs.BoolVar(&cfg.verbose, "verbose", false, "print the status and output of every check")
+	fs.IntVar(&exitPass, "exit-pass", 0, "exit code for the pass status")
+	fs.IntVar(&exitWarn, "exit-warn", 1, "exit code for the warn status")
+	fs.IntVar(&exitFail, "exit-fail", 2, "exit code for the fail status, and for errors")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		cfg.url = fs.Arg(0)
+	default:
+		fs.Usage()
+		return 2
+	}
+
+	if cfg.quiet && cfg.verbose {
+		fmt.Fprintln(stderr, "healthprobe: -quiet and -verbose cannot be used together")
+		return 2
+	}
+
+	cfg.exitCodes = map[checks.Status]int{
+		checks.StatusPass: exitPass,
+		checks.StatusWarn: exitWarn,
+		checks.StatusFail: exitFail,
+	}
+
+	resp, err := probe(cfg)
+	if err != nil {
+		if !cfg.quiet {
+			fmt.Fprintf(stderr, "fail: %v\n", err)
+		}
+		return exitFail
+	}
+
+	if !cfg.quiet {
+		report(stdout, resp, cfg.verbose)
+	}
+
+	code, ok := cfg.exitCodes[resp.Status]
+	if !ok {
+		return exitFail
+	}
+	return code
+}
+
+// probe calls the health endpoint and decodes its response.
+func probe(cfg config) (healthcheck.HealthHttpResponse, error) {
+	var resp healthcheck.HealthHttpResponse
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.url, nil)
+	if err != nil {
+		return resp, fmt.Errorf("invalid URL: %w", err)
+	}
+	req.Header.Set("Accept", "application/health+json, application/json")
+	for _, header := range cfg.headers {
+		name, value, _ := strings.Cut(header, ":")
+		req.Header.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	client := &http.Client{}
+	if cfg.unixSocket != "" {
+		client.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", cfg.unixSocket)
+			},
+		}
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxBodySize))
+	if err != nil {
+		return resp, fmt.Errorf("reading response: %w", err)
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return resp, fmt.Errorf("unexpected response with status code %d: %w", res.StatusCode, err)
+	}
+	if resp.Status == "" {
+		return resp, fmt.Errorf("unexpected response with status code %d: missing status", res.StatusCode)
+	}
+
+	return resp, nil
+}
+
+// report prints the status of the response and, in verbose mode, the status of every check.
+func report(w io.Writer, resp healthcheck.HealthHttpResponse, verbose bool) {
+	if resp.Output != "" && !verbose {
+		fmt.Fprintf(w, "%s: %s\n", resp.Status, resp.Output)
+	} else {
+		fmt.Fprintln(w, resp.Status)
+	}
+
+	if !verbose {
+		return
+	}
+
+	names := make([]string, 0, len(resp.Checks))
+	for name := range resp.Checks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		for _, result := range resp.Checks[name] {
+			line := fmt.Sprintf("  %s: %s", name, result.Status)
+			if result.Output != "" {
+				line += ": " + result.Output
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHealthServer(t *testing.T, code int, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/health+json")
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	t.Run("exits with the code of the status", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			body string
+			code int
+			want int
+		}{
+			{body: `{"status":"pass","checks":{}}`, code: http.StatusOK, want: 0},
+			{body: `{"status":"warn","checks":{}}`, code: http.StatusOK, want: 1},
+			{body: `{"status":"fail","checks":{}}`, code: http.StatusServiceUnavailable, want: 2},
+		}
+
+		for _, tt := range tests {
+			server := newHealthServer(t, tt.code, tt.body)
+			var stdout, stderr bytes.Buffer
+
+			assert.Equal(t, tt.want, run([]string{server.URL}, &stdout, &stderr), tt.body)
+		}
+	})
+
+	t.Run("uses configured exit codes", func(t *testing.T) {
+		t.Parallel()
+
+		server := newHealthServer(t, http.StatusOK, `{"status":"warn","checks":{}}`)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"-exit-warn=0", "-url=" + server.URL}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code)
+	})
+
+	t.Run("prints the status and output", func(t *testing.T) {
+		t.Parallel()
+
+		server := newHealthServer(t, http.StatusServiceUnavailable, `{"status":"fail","output":"db: connection refused","checks":{}}`)
+		var stdout, stderr bytes.Buffer
+
+		run([]string{server.URL}, &stdout, &stderr)
+
+		assert.Equal(t, "fail: db: connection refused\n", stdout.String())
+	})
+
+	t.Run("prints every check in verbose mode", func(t *testing.T) {
+		t.Parallel()
+
+		server := newHealthServer(t, http.StatusOK, `{"status":"warn","checks":{
+			"disk":[{"status":"warn","output":"disk usage high"}],
+			"api":[{"status":"pass"}]
+		}}`)
+		var stdout, stderr bytes.Buffer
+
+		run([]string{"-verbose", server.URL}, &stdout, &stderr)
+
+		assert.Equal(t, "warn\n  api: pass\n  disk: warn: disk usage high\n", stdout.String())
+	})
+
+	t.Run("prints nothing in quiet mode", func(t *testing.T) {
+		t.Parallel()
+
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"-quiet", "-timeout=100ms", "http://127.0.0.1:1/health"}, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Empty(t, stdout.String())
+		assert.Empty(t, stderr.String())
+	})
+
+	t.Run("sends headers", func(t *testing.T) {
+		t.Parallel()
+
+		var received http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r.Header.Clone()
+			_, _ = w.Write([]byte(`{"status":"pass"}`))
+		}))
+		t.Cleanup(server.Close)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"-header", "Authorization: Bearer token", "-header=X-Request-Id:probe", server.URL}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code)
+		assert.Equal(t, "Bearer token", received.Get("Authorization"))
+		assert.Equal(t, "probe", received.Get("X-Request-Id"))
+	})
+
+	t.Run("fails on invalid responses", func(t *testing.T) {
+		t.Parallel()
+
+		server := newHealthServer(t, http.StatusBadGateway, `<html>bad gateway</html>`)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{server.URL}, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), "fail: unexpected response with status code 502")
+	})
+
+	t.Run("fails on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		t.Cleanup(server.Close)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"-timeout=20ms", server.URL}, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), "context deadline exceeded")
+	})
+
+	t.Run("connects through a unix socket", func(t *testing.T) {
+		t.Parallel()
+
+		socket := filepath.Join(t.TempDir(), "health.sock")
+		listener, err := net.Listen("unix", socket)
+		require.NoError(t, err)
+
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":"pass"}`))
+		}))
+		server.Listener = listener
+		server.Start()
+		t.Cleanup(server.Close)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"-unix-socket", socket, "http://localhost/health"}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code)
+		assert.Equal(t, "pass\n", stdout.String())
+	})
+
+	t.Run("rejects invalid flags", func(t *testing.T) {
+		t.Parallel()
+
+		var stdout, stderr bytes.Buffer
+
+		assert.Equal(t, 2, run([]string{"-header", "invalid"}, &stdout, &stderr))
+		assert.Equal(t, 2, run([]string{"-quiet", "-verbose"}, &stdout, &stderr))
+	})
+}
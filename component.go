@@ -0,0 +1,88 @@
+package healthcheck
+
+import (
+	"maps"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// componentConfig holds the RFC component fields applied to the results of a registered check.
+type componentConfig struct {
+	componentID       string
+	componentType     string
+	affectedEndpoints []string
+	links             map[string]string
+}
+
+// WithNotes sets the notes of the service, reported in the health response.
+func WithNotes(notes ...string) Option {
+	return func(h *HealthCheck) {
+		h.Notes = append(h.Notes, notes...)
+	}
+}
+
+// WithLink adds a link of the service, reported in the health response, with the given relation and URI.
+func WithLink(rel, href string) Option {
+	return func(h *HealthCheck) {
+		if h.Links == nil {
+			h.Links = make(map[string]string)
+		}
+		h.Links[rel] = href
+	}
+}
+
+// WithCheckComponentID sets the componentId reported in the results of the check, unless the check sets it.
+func WithCheckComponentID(id string) CheckOption {
+	return func(c *checkConfig) {
+		c.component.componentID = id
+	}
+}
+
+// WithCheckComponentType sets the componentType reported in the results of the check, unless the check sets it.
+func WithCheckComponentType(componentType string) CheckOption {
+	return func(c *checkConfig) {
+		c.component.componentType = componentType
+	}
+}
+
+// WithCheckAffectedEndpoints sets the endpoints of the service affected when the check is not passing.
+// They are only reported in results with StatusWarn or StatusFail.
+func WithCheckAffectedEndpoints(endpoints ...string) CheckOption {
+	return func(c *checkConfig) {
+		c.component.affectedEndpoints = append(c.component.affectedEndpoints, endpoints...)
+	}
+}
+
+// WithCheckLink adds a link reported in the results of the check, with the given relation and URI.
+func WithCheckLink(rel, href string) CheckOption {
+	return func(c *checkConfig) {
+		if c.component.links == nil {
+			c.component.links = make(map[string]string)
+		}
+		c.component.links[rel] = href
+	}
+}
+
+// describe fills the RFC component fields of the result with the values configured for the check.
+// Values set by the check itself take precedence.
+func (c componentConfig) describe(result checks.Result) checks.Result {
+	if result.ComponentID == "" {
+		result.ComponentID = c.componentID
+	}
+
+	if result.ComponentType == "" {
+		result.ComponentType = c.componentType
+	}
+
+	if len(result.AffectedEndpoints) == 0 && result.Status != checks.StatusPass {
+		result.AffectedEndpoints = c.affectedEndpoints
+	}
+
+	if len(c.links) > 0 {
+		links := maps.Clone(c.links)
+		maps.Copy(links, result.Links)
+		result.Links = links
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_ComponentFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Fills Component Fields Of Results", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("postgres"), mockcheck.WithStatus(checks.StatusFail)),
+				healthcheck.WithCheckComponentID("6fd416e0-8920-410f-9c7b-c479000f7227"),
+				healthcheck.WithCheckComponentType(checks.ComponentTypeDatastore),
+				healthcheck.WithCheckAffectedEndpoints("/api/v1/users"),
+				healthcheck.WithCheckLink("self", "http://db.example.com/health"),
+			),
+		)
+
+		result := hc.Execute(context.Background()).Checks["postgres"][0]
+
+		assert.Equal(t, "6fd416e0-8920-410f-9c7b-c479000f7227", result.ComponentID)
+		assert.Equal(t, checks.ComponentTypeDatastore, result.ComponentType)
+		assert.Equal(t, []string{"/api/v1/users"}, result.AffectedEndpoints)
+		assert.Equal(t, map[string]string{"self": "http://db.example.com/health"}, result.Links)
+	})
+
+	t.Run("Omits Affected Endpoints For Passing Checks", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("postgres")),
+				healthcheck.WithCheckAffectedEndpoints("/api/v1/users"),
+			),
+		)
+
+		result := hc.Execute(context.Background()).Checks["postgres"][0]
+
+		assert.Empty(t, result.AffectedEndpoints)
+	})
+
+	t.Run("Keeps Component Fields Set By The Check", func(t *testing.T) {
+		t.Parallel()
+
+		check := mockcheck.NewCheck(mockcheck.WithName("api"), mockcheck.WithResults(checks.Result{
+			ComponentType: checks.ComponentTypeComponent,
+			Status:        checks.StatusPass,
+		}))
+		hc := newHealthTest(
+			healthcheck.WithCheck(check, healthcheck.WithCheckComponentType("system")),
+		)
+
+		result := hc.Execute(context.Background()).Checks["api"][0]
+
+		assert.Equal(t, checks.ComponentTypeComponent, result.ComponentType)
+	})
+}
@@ -0,0 +1,81 @@
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// WithMaxConcurrency limits the number of checks running at the same time, across all executions.
+// Checks waiting for a slot count towards the global timeout, but not towards their own timeout.
+func WithMaxConcurrency(n int) Option {
+	return func(h *HealthCheck) {
+		if n > 0 {
+			h.limiter = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithGroupConcurrency limits the number of checks of the given group running at the same time.
+// Checks are assigned to a group with WithCheckGroup.
+func WithGroupConcurrency(group string, n int) Option {
+	return func(h *HealthCheck) {
+		if n <= 0 {
+			return
+		}
+		if h.groupLimiters == nil {
+			h.groupLimiters = make(map[string]chan struct{})
+		}
+		h.groupLimiters[group] = make(chan struct{}, n)
+	}
+}
+
+// WithCheckGroup assigns the check to a concurrency group, such as the upstream it targets,
+// limited with WithGroupConcurrency.
+func WithCheckGroup(group string) CheckOption {
+	return func(c *checkConfig) {
+		c.group = group
+	}
+}
+
+// acquire waits for a slot in the group and global limiters of the check.
+// It returns a function releasing the slots, or false if the context is done before a slot is available.
+func (h *HealthCheck) acquire(ctx context.Context, check checks.Check) (func(), bool) {
+	var acquired []chan struct{}
+	release := func() {
+		for _, limiter := range acquired {
+			<-limiter
+		}
+	}
+
+	// Acquire the group slot first, so that checks waiting for a busy group do not hold global slots.
+	for _, limiter := range []chan struct{}{h.groupLimiters[h.config(check).group], h.limiter} {
+		if limiter == nil {
+			continue
+		}
+
+		select {
+		case limiter <- struct{}{}:
+			acquired = append(acquired, limiter)
+		case <-ctx.Done():
+			release()
+			return nil, false
+		}
+	}
+
+	return release, true
+}
+
+// runLimited runs the check once a concurrency slot is available. The slot is held until the check
+// returns, even after it timed out, so that checks ignoring their context do not exceed the limits.
+func (h *HealthCheck) runLimited(ctx context.Context, check checks.Check, timeout time.Duration) checks.Result {
+	start := time.Now()
+
+	release, ok := h.acquire(ctx, check)
+	if !ok {
+		return h.timeoutResult(ctx, ctx, 0, time.Since(start))
+	}
+
+	return h.runWithTimeout(ctx, check, timeout, release)
+}
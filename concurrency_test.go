@@ -0,0 +1,136 @@
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+// inFlightCounter tracks the number of checks running at the same time.
+type inFlightCounter struct {
+	current atomic.Int32
+	max     atomic.Int32
+}
+
+// track returns a mock check hook reporting to the counter while it runs for the given delay,
+// ignoring its context.
+func (c *inFlightCounter) track(delay time.Duration) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		current := c.current.Add(1)
+		defer c.current.Add(-1)
+
+		for {
+			peak := c.max.Load()
+			if current <= peak || c.max.CompareAndSwap(peak, current) {
+				break
+			}
+		}
+		time.Sleep(delay)
+	}
+}
+
+func TestHealthCheck_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Limits Checks Running At Once", func(t *testing.T) {
+		t.Parallel()
+
+		counter := &inFlightCounter{}
+		opts := []healthcheck.Option{healthcheck.WithMaxConcurrency(3)}
+		for i := range 12 {
+			opts = append(opts, healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName(fmt.Sprintf("check-%d", i)),
+				mockcheck.WithHook(counter.track(10*time.Millisecond)),
+			)))
+		}
+		hc := newHealthTest(opts...)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Len(t, result.Checks, 12)
+		assert.Equal(t, int32(3), counter.max.Load())
+	})
+
+	t.Run("Limits Checks Running At Once Per Group", func(t *testing.T) {
+		t.Parallel()
+
+		upstream := &inFlightCounter{}
+		others := &inFlightCounter{}
+		opts := []healthcheck.Option{healthcheck.WithGroupConcurrency("upstream", 2)}
+		for i := range 6 {
+			opts = append(opts,
+				healthcheck.WithCheck(
+					mockcheck.NewCheck(
+						mockcheck.WithName(fmt.Sprintf("upstream-%d", i)),
+						mockcheck.WithHook(upstream.track(10*time.Millisecond)),
+					),
+					healthcheck.WithCheckGroup("upstream"),
+				),
+				healthcheck.WithCheck(mockcheck.NewCheck(
+					mockcheck.WithName(fmt.Sprintf("other-%d", i)),
+					mockcheck.WithHook(others.track(10*time.Millisecond)),
+				)),
+			)
+		}
+		hc := newHealthTest(opts...)
+
+		hc.Execute(context.Background())
+
+		assert.Equal(t, int32(2), upstream.max.Load())
+		assert.Equal(t, int32(6), others.max.Load())
+	})
+
+	t.Run("Times Out Checks Waiting For A Slot", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithMaxConcurrency(1),
+			healthcheck.WithTimeout(50*time.Millisecond),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("slow-1"),
+				mockcheck.WithDelay(200*time.Millisecond),
+			)),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("slow-2"),
+				mockcheck.WithDelay(200*time.Millisecond),
+			)),
+		)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "timed out after 50ms", result.Checks["slow-1"][0].Output)
+		assert.Equal(t, "timed out after 50ms", result.Checks["slow-2"][0].Output)
+	})
+
+	t.Run("Holds Slots Of Timed Out Checks Until They Return", func(t *testing.T) {
+		t.Parallel()
+
+		counter := &inFlightCounter{}
+		opts := []healthcheck.Option{healthcheck.WithMaxConcurrency(2)}
+		for i := range 6 {
+			opts = append(opts, healthcheck.WithCheck(
+				mockcheck.NewCheck(
+					mockcheck.WithName(fmt.Sprintf("check-%d", i)),
+					mockcheck.WithHook(counter.track(30*time.Millisecond)),
+				),
+				healthcheck.WithCheckTimeout(5*time.Millisecond),
+			))
+		}
+		hc := newHealthTest(opts...)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, int32(2), counter.max.Load())
+	})
+}
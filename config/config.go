@@ -0,0 +1,256 @@
+// Package config builds a HealthCheck from a declarative configuration, read from a JSON or YAML file,
+// or from environment variables, so that checks can be changed without recompiling.
+//
+// Example configuration:
+//
+//	serviceId: orders
+//	timeout: 5s
+//	checks:
+//	  - type: tcp
+//	    name: tcp:postgres
+//	    host: ${DB_HOST}
+//	    port: 5432
+//	    probes: [readiness]
+//	  - type: disk
+//	    path: /data
+//	    warn: 80
+//	    fail: 90
+//	    nonCritical: true
+//
+// Check types are resolved with a Registry, which provides the http, tcp, disk and memory types,
+// and can be extended with custom types. Errors are reported with the position of the invalid value,
+// and checks implementing checks.Validator are validated when they are built.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// ErrUnknownType is reported for checks whose type is not registered.
+var ErrUnknownType = errors.New("unknown check type")
+
+// loader holds the settings used to build the HealthCheck.
+type loader struct {
+	registry *Registry
+	options  []healthcheck.Option
+}
+
+// Option is a functional option for configuring how the configuration is loaded.
+type Option func(*loader)
+
+// WithRegistry sets the registry used to resolve check types (default: NewRegistry()).
+func WithRegistry(registry *Registry) Option {
+	return func(l *loader) {
+		l.registry = registry
+	}
+}
+
+// WithOptions applies the given options to the HealthCheck before the configuration,
+// for settings that cannot be configured declaratively, such as status listeners.
+func WithOptions(opts ...healthcheck.Option) Option {
+	return func(l *loader) {
+		l.options = append(l.options, opts...)
+	}
+}
+
+// Load reads the JSON or YAML configuration file at path and builds a HealthCheck from it.
+// Environment variables referenced as ${VAR} in values are expanded.
+// The returned error lists every invalid value, with its file:line:column position.
+func Load(path string, opts ...Option) (*healthcheck.HealthCheck, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(path, data, opts...)
+}
+
+// Parse builds a HealthCheck from the given JSON or YAML configuration.
+// The source name is used in the positions of the errors.
+func Parse(source string, data []byte, opts ...Option) (*healthcheck.HealthCheck, error) {
+	root, err := fromYAML(source, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return build(root, opts)
+}
+
+// LoadEnv builds a HealthCheck from the environment variables with the given prefix.
+// Fields are named in upper snake case: for example, with the HEALTHCHECK prefix,
+// HEALTHCHECK_SERVICE_ID sets serviceId, and HEALTHCHECK_CHECKS_0_WARN_RECOVERY sets the
+// warnRecovery field of the first check. Lists are comma-separated.
+func LoadEnv(prefix string, opts ...Option) (*healthcheck.HealthCheck, error) {
+	root, err := fromEnv(prefix, os.Environ())
+	if err != nil {
+		return nil, err
+	}
+
+	return build(root, opts)
+}
+
+// build creates the HealthCheck described by the root configuration node.
+func build(root *node, opts []Option) (*healthcheck.HealthCheck, error) {
+	l := &loader{}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.registry == nil {
+		l.registry = NewRegistry()
+	}
+
+	spec := newSpec(root)
+	hcOpts := slices.Clone(l.options)
+	if spec.Has("serviceId") {
+		hcOpts = append(hcOpts, healthcheck.WithServiceID(spec.String("serviceId")))
+	}
+	if spec.Has("description") {
+		hcOpts = append(hcOpts, healthcheck.WithDescription(spec.String("description")))
+	}
+	if spec.Has("version") {
+		hcOpts = append(hcOpts, healthcheck.WithVersion(spec.String("version")))
+	}
+	if spec.Has("releaseId") {
+		hcOpts = append(hcOpts, healthcheck.WithReleaseID(spec.String("releaseId")))
+	}
+	if spec.Has("notes") {
+		hcOpts = append(hcOpts, healthcheck.WithNotes(spec.Strings("notes")...))
+	}
+	if spec.Has("timeout") {
+		hcOpts = append(hcOpts, healthcheck.WithTimeout(spec.Duration("timeout")))
+	}
+	if spec.Has("interval") {
+		hcOpts = append(hcOpts, healthcheck.WithInterval(spec.Duration("interval")))
+	}
+	if spec.Has("maxConcurrency") {
+		hcOpts = append(hcOpts, healthcheck.WithMaxConcurrency(spec.Int("maxConcurrency")))
+	}
+	if spec.Has("historySize") {
+		hcOpts = append(hcOpts, healthcheck.WithHistorySize(spec.Int("historySize")))
+	}
+
+	spec.used["checks"] = true
+	entries, err := checkEntries(root)
+	errs := []error{spec.err(), err}
+
+	hc := healthcheck.New(hcOpts...)
+	for _, entry := range entries {
+		if err := l.addCheck(hc, entry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := joinErrors(errs); err != nil {
+		return nil, err
+	}
+	return hc, nil
+}
+
+// checkEntries returns the object nodes of the checks list.
+func checkEntries(root *node) ([]*node, error) {
+	list, ok := root.fields["checks"]
+	if !ok {
+		return nil, nil
+	}
+	if list.kind != listNode {
+		return nil, &Error{Position: list.pos, Err: fmt.Errorf("field %q: expected a list of checks", "checks")}
+	}
+
+	var entries []*node
+	var errs []error
+	for _, item := range list.items {
+		if item.kind != objectNode {
+			errs = append(errs, &Error{Position: item.pos, Err: fmt.Errorf("expected a check object")})
+			continue
+		}
+		entries = append(entries, item)
+	}
+	return entries, joinErrors(errs)
+}
+
+// addCheck builds the check described by the entry and registers it in the HealthCheck.
+func (l *loader) addCheck(hc *healthcheck.HealthCheck, entry *node) error {
+	spec := newSpec(entry)
+	spec.Type = spec.String("type")
+	spec.Name = spec.String("name")
+	spec.Require("type")
+
+	checkOpts := checkOptions(spec)
+
+	// Without a known type, the other fields cannot be validated.
+	if spec.Type == "" {
+		return joinErrors(spec.errs)
+	}
+
+	factory, ok := l.registry.Factory(spec.Type)
+	if !ok {
+		spec.Errorf("type", "%w %q (available: %v)", ErrUnknownType, spec.Type, l.registry.Types())
+		return joinErrors(spec.errs)
+	}
+
+	check, err := factory(spec)
+	if err != nil {
+		spec.errs = append(spec.errs, &Error{Position: entry.pos, Err: err})
+	}
+	if err := spec.err(); err != nil {
+		return err
+	}
+
+	if err := checks.Validate(check); err != nil {
+		return &Error{Position: entry.pos, Err: err}
+	}
+
+	if err := hc.AddCheck(check, checkOpts...); err != nil {
+		return &Error{Position: entry.pos, Err: err}
+	}
+	return nil
+}
+
+// checkOptions returns the options of the check common to every type:
+// tags, probes, dependsOn, impact, nonCritical, interval and group.
+func checkOptions(spec *Spec) []healthcheck.CheckOption {
+	var opts []healthcheck.CheckOption
+
+	if spec.Has("tags") {
+		opts = append(opts, healthcheck.WithCheckTags(spec.Strings("tags")...))
+	}
+	if spec.Has("probes") {
+		var probes []healthcheck.Probe
+		for _, probe := range spec.Strings("probes") {
+			p := healthcheck.Probe(probe)
+			if !slices.Contains([]healthcheck.Probe{healthcheck.ProbeLiveness, healthcheck.ProbeReadiness, healthcheck.ProbeStartup}, p) {
+				spec.Errorf("probes", "field %q: unknown probe %q", "probes", probe)
+				continue
+			}
+			probes = append(probes, p)
+		}
+		opts = append(opts, healthcheck.WithCheckProbes(probes...))
+	}
+	if spec.Has("dependsOn") {
+		opts = append(opts, healthcheck.WithCheckDependsOn(spec.Strings("dependsOn")...))
+	}
+	if spec.Has("impact") {
+		impact := checks.Status(spec.String("impact"))
+		if !slices.Contains([]checks.Status{checks.StatusPass, checks.StatusWarn, checks.StatusFail}, impact) {
+			spec.Errorf("impact", "field %q: unknown status %q", "impact", impact)
+		}
+		opts = append(opts, healthcheck.WithCheckImpact(impact))
+	}
+	if spec.Has("nonCritical") && spec.Bool("nonCritical") {
+		opts = append(opts, healthcheck.WithCheckNonCritical())
+	}
+	if spec.Has("interval") {
+		opts = append(opts, healthcheck.WithCheckInterval(spec.Duration("interval")))
+	}
+	if spec.Has("group") {
+		opts = append(opts, healthcheck.WithCheckGroup(spec.String("group")))
+	}
+
+	return opts
+}
@@ -0,0 +1,244 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+	"github.com/brpaz/go-healthcheck/v2/config"
+)
+
+// newMockRegistry returns a registry with a "mock" type, building mock checks with the given status.
+func newMockRegistry() *config.Registry {
+	registry := config.NewRegistry()
+	registry.Register("mock", func(spec *config.Spec) (checks.Check, error) {
+		return mockcheck.NewCheck(
+			mockcheck.WithName(spec.Name),
+			mockcheck.WithStatus(checks.Status(spec.String("status"))),
+		), nil
+	})
+	return registry
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("builds health check from yaml", func(t *testing.T) {
+		t.Parallel()
+
+		hc, err := config.Parse("health.yaml", []byte(`
+serviceId: orders
+description: Orders service
+version: 1.2.0
+releaseId: sha-123
+notes: [managed by ops]
+timeout: 5s
+maxConcurrency: 4
+checks:
+  - type: tcp
+    name: tcp:postgres
+    host: db
+    port: 5432
+    timeout: 2s
+    probes: [readiness]
+    tags: database
+  - type: http
+    name: http:payments
+    url: http://payments/health
+    expectedStatus: [200, 204]
+    dependsOn: [tcp:postgres]
+  - type: disk
+    path: /data
+    warn: 80
+    fail: 90.5
+    warnRecovery: 75
+    nonCritical: true
+  - type: memory
+    warn: 70
+    interval: 1m
+`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "orders", hc.ServiceID)
+		assert.Equal(t, "Orders service", hc.Description)
+		assert.Equal(t, "1.2.0", hc.Version)
+		assert.Equal(t, "sha-123", hc.ReleaseID)
+		assert.Equal(t, []string{"managed by ops"}, hc.Notes)
+		assert.Equal(t, []string{"disk-check", "http:payments", "memory", "tcp:postgres"}, hc.CheckNames())
+	})
+
+	t.Run("builds health check from json", func(t *testing.T) {
+		t.Parallel()
+
+		hc, err := config.Parse("health.json", []byte(`{
+  "serviceId": "orders",
+  "checks": [
+    {"type": "mock", "name": "database", "status": "fail", "impact": "warn", "tags": ["db"]},
+    {"type": "mock", "name": "cache", "status": "pass", "group": "redis"}
+  ]
+}`), config.WithRegistry(newMockRegistry()))
+
+		require.NoError(t, err)
+		result := hc.Execute(context.Background())
+		assert.Equal(t, checks.StatusWarn, result.Status)
+
+		filtered := hc.ExecuteFiltered(context.Background(), healthcheck.Filter{Tags: []string{"db"}})
+		assert.Len(t, filtered.Checks, 1)
+		assert.Contains(t, filtered.Checks, "database")
+	})
+
+	t.Run("applies extra options", func(t *testing.T) {
+		t.Parallel()
+
+		hc, err := config.Parse("health.yaml", []byte(`serviceId: orders`),
+			config.WithOptions(healthcheck.WithServiceID("default"), healthcheck.WithVersion("1.0.0")))
+
+		require.NoError(t, err)
+		assert.Equal(t, "orders", hc.ServiceID)
+		assert.Equal(t, "1.0.0", hc.Version)
+	})
+
+	t.Run("reports errors with positions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := config.Parse("health.yaml", []byte(`serviceId: orders
+timeout: soon
+checks:
+  - type: tcp
+    host: db
+    port: postgres
+  - type: tcp
+    name: tcp:redis
+    port: 6379
+  - type: ldap
+    host: ldap
+  - type: disk
+    warn: 80
+    critical: 95
+  - name: untyped
+  - type: memory
+    probes: [readiness, warmup]
+    impact: critical
+`))
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, config.ErrUnknownType)
+		for _, want := range []string{
+			`health.yaml:2:10: field "timeout": expected a duration, got "soon"`,
+			`health.yaml:6:11: field "port": expected an integer, got "postgres"`,
+			`health.yaml:7:5: missing required field "host"`,
+			`health.yaml:10:11: unknown check type "ldap" (available: [disk http memory tcp])`,
+			`health.yaml:14:5: unknown field "critical"`,
+			`health.yaml:15:5: missing required field "type"`,
+			`health.yaml:17:13: field "probes": unknown probe "warmup"`,
+			`health.yaml:18:13: field "impact": unknown status "critical"`,
+		} {
+			assert.Contains(t, err.Error(), want)
+		}
+	})
+
+	t.Run("reports unknown top-level fields", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := config.Parse("health.yaml", []byte("serviceId: orders\nretries: 3\n"))
+
+		require.Error(t, err)
+		assert.EqualError(t, err, `health.yaml:2:1: unknown field "retries"`)
+	})
+
+	t.Run("reports invalid check configuration", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := config.Parse("health.yaml", []byte(`checks:
+  - type: tcp
+    host: db
+    port: 70000
+  - type: disk
+    warn: 95
+    fail: 90
+`))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "health.yaml:2:5: port must be between 1 and 65535, got 70000")
+		assert.Contains(t, err.Error(), "health.yaml:5:5: warn threshold 95.0 is above fail threshold 90.0")
+	})
+
+	t.Run("reports registration errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := config.Parse("health.yaml", []byte(`checks:
+  - type: mock
+    name: database
+  - type: mock
+    name: database
+`), config.WithRegistry(newMockRegistry()))
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, healthcheck.ErrDuplicateCheck)
+		assert.Contains(t, err.Error(), "health.yaml:4:5: ")
+	})
+
+	t.Run("reports syntax errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := config.Parse("health.yaml", []byte("checks: [\n"))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "health.yaml: ")
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("CONFIG_TEST_DB_HOST", "postgres.internal")
+
+	path := filepath.Join(t.TempDir(), "health.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`checks:
+  - type: mock
+    name: tcp:${CONFIG_TEST_DB_HOST}
+    status: pass
+  - type: mock
+    name: http://search/$CONFIG_TEST_DB_HOST?q=$1
+    status: pass
+`), 0o600))
+
+	hc, err := config.Load(path, config.WithRegistry(newMockRegistry()))
+
+	require.NoError(t, err)
+	assert.True(t, hc.HasCheck("tcp:postgres.internal"))
+	assert.True(t, hc.HasCheck("http://search/$CONFIG_TEST_DB_HOST?q=$1"))
+
+	_, err = config.Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadEnv(t *testing.T) {
+	t.Setenv("HCTEST_SERVICE_ID", "orders")
+	t.Setenv("HCTEST_TIMEOUT", "5s")
+	t.Setenv("HCTEST_CHECKS_0_TYPE", "disk")
+	t.Setenv("HCTEST_CHECKS_0_WARN_RECOVERY", "70")
+	t.Setenv("HCTEST_CHECKS_10_TYPE", "mock")
+	t.Setenv("HCTEST_CHECKS_10_NAME", "database")
+	t.Setenv("HCTEST_CHECKS_10_STATUS", "warn")
+	t.Setenv("HCTEST_CHECKS_10_DEPENDS_ON", "disk-check")
+
+	hc, err := config.LoadEnv("HCTEST", config.WithRegistry(newMockRegistry()))
+
+	require.NoError(t, err)
+	assert.Equal(t, "orders", hc.ServiceID)
+	assert.Equal(t, []string{"database", "disk-check"}, hc.CheckNames())
+	assert.Equal(t, "disk-check", hc.GetChecks()[0].GetName())
+
+	t.Setenv("HCTEST_CHECKS_10_PORT", "5432")
+
+	_, err = config.LoadEnv("HCTEST", config.WithRegistry(newMockRegistry()))
+
+	require.Error(t, err)
+	assert.EqualError(t, err, `environment variable HCTEST_CHECKS_10_PORT: unknown field "port"`)
+}
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// nodeKind is the kind of a configuration node.
+type nodeKind int
+
+const (
+	scalarNode nodeKind = iota
+	listNode
+	objectNode
+)
+
+// node is a configuration value, read from a file or from environment variables,
+// with the position it was defined at.
+type node struct {
+	pos    string
+	kind   nodeKind
+	value  string
+	items  []*node
+	keys   []string
+	fields map[string]*node
+	// keyPos holds the position of each key of an object, for errors about the key itself.
+	keyPos map[string]string
+}
+
+// newObject returns an empty object node defined at the given position.
+func newObject(pos string) *node {
+	return &node{
+		pos:    pos,
+		kind:   objectNode,
+		fields: make(map[string]*node),
+		keyPos: make(map[string]string),
+	}
+}
+
+// set adds a field to the object node, keeping the order in which the fields were defined.
+func (n *node) set(key, pos string, value *node) {
+	if _, ok := n.fields[key]; !ok {
+		n.keys = append(n.keys, key)
+	}
+	n.fields[key] = value
+	n.keyPos[key] = pos
+}
+
+// fromYAML converts a YAML document, or a JSON document, into a configuration node.
+// Environment variables referenced as ${VAR} in scalar values are expanded.
+func fromYAML(source string, data []byte) (*node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, &Error{Position: source, Err: err}
+	}
+
+	if len(doc.Content) == 0 {
+		return newObject(source), nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, &Error{Position: yamlPos(source, root), Err: fmt.Errorf("expected an object")}
+	}
+
+	return convertYAML(source, root), nil
+}
+
+// convertYAML converts a YAML node into a configuration node.
+func convertYAML(source string, y *yaml.Node) *node {
+	pos := yamlPos(source, y)
+
+	switch y.Kind {
+	case yaml.MappingNode:
+		n := newObject(pos)
+		for i := 0; i+1 < len(y.Content); i += 2 {
+			key := y.Content[i]
+			n.set(key.Value, yamlPos(source, key), convertYAML(source, y.Content[i+1]))
+		}
+		return n
+	case yaml.SequenceNode:
+		n := &node{pos: pos, kind: listNode}
+		for _, item := range y.Content {
+			n.items = append(n.items, convertYAML(source, item))
+		}
+		return n
+	case yaml.AliasNode:
+		return convertYAML(source, y.Alias)
+	default:
+		value := y.Value
+		if y.Tag == "!!null" {
+			value = ""
+		}
+		return &node{pos: pos, kind: scalarNode, value: expandEnv(value)}
+	}
+}
+
+// envReference matches the ${VAR} references to environment variables.
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandEnv replaces the ${VAR} references in the value with the values of the environment variables.
+// Other uses of $, such as $VAR or $1, are kept as is.
+func expandEnv(value string) string {
+	return envReference.ReplaceAllStringFunc(value, func(ref string) string {
+		return os.Getenv(ref[2 : len(ref)-1])
+	})
+}
+
+// yamlPos returns the file:line:column position of the YAML node.
+func yamlPos(source string, y *yaml.Node) string {
+	return fmt.Sprintf("%s:%d:%d", source, y.Line, y.Column)
+}
+
+// fromEnv converts the environment variables with the given prefix into a configuration node.
+// PREFIX_SERVICE_ID sets the serviceId field, and PREFIX_CHECKS_<N>_<FIELD> sets a field of the check
+// at index N, such as PREFIX_CHECKS_0_WARN_RECOVERY for warnRecovery.
+func fromEnv(prefix string, environ []string) (*node, error) {
+	root := newObject("environment")
+	entries := make(map[int]*node)
+	var errs []error
+
+	prefix = strings.TrimSuffix(prefix, "_") + "_"
+	for _, env := range environ {
+		name, value, _ := strings.Cut(env, "=")
+		key, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+
+		pos := "environment variable " + name
+		scalar := &node{pos: pos, kind: scalarNode, value: value}
+
+		rest, isCheck := strings.CutPrefix(key, "CHECKS_")
+		if !isCheck {
+			root.set(camelCase(key), pos, scalar)
+			continue
+		}
+
+		index, field, ok := strings.Cut(rest, "_")
+		i, err := strconv.Atoi(index)
+		if !ok || err != nil || i < 0 || field == "" {
+			errs = append(errs, &Error{Position: pos, Err: fmt.Errorf("expected %sCHECKS_<index>_<FIELD>", prefix)})
+			continue
+		}
+
+		entry, ok := entries[i]
+		if !ok {
+			entry = newObject(fmt.Sprintf("environment variables %sCHECKS_%d_*", prefix, i))
+			entries[i] = entry
+		}
+		entry.set(camelCase(field), pos, scalar)
+	}
+
+	if len(entries) > 0 {
+		indexes := make([]int, 0, len(entries))
+		for i := range entries {
+			indexes = append(indexes, i)
+		}
+		slices.Sort(indexes)
+
+		list := &node{pos: "environment variables " + prefix + "CHECKS_*", kind: listNode}
+		for _, i := range indexes {
+			list.items = append(list.items, entries[i])
+		}
+		root.set("checks", list.pos, list)
+	}
+
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+	return root, nil
+}
+
+// camelCase converts an UPPER_SNAKE_CASE environment variable suffix into a camelCase field name.
+func camelCase(s string) string {
+	var b strings.Builder
+	for i, part := range strings.Split(strings.ToLower(s), "_") {
+		if part == "" {
+			continue
+		}
+		if i > 0 {
+			runes := []rune(part)
+			runes[0] = unicode.ToUpper(runes[0])
+			part = string(runes)
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
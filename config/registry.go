@@ -0,0 +1,152 @@
+package config
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/diskcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/httpcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/memorycheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/tcpcheck"
+)
+
+// Factory builds a check from its configuration.
+// Errors recorded while reading the Spec are reported by the loader, with their positions.
+type Factory func(spec *Spec) (checks.Check, error)
+
+// Registry maps check types to the factories that build them.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewRegistry creates a Registry with the built-in check types: http, tcp, disk and memory.
+func NewRegistry() *Registry {
+	r := &Registry{factories: make(map[string]Factory)}
+	r.Register("http", newHTTPCheck)
+	r.Register("tcp", newTCPCheck)
+	r.Register("disk", newDiskCheck)
+	r.Register("memory", newMemoryCheck)
+	return r
+}
+
+// Register registers the factory for the given check type, replacing any existing one.
+func (r *Registry) Register(checkType string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.factories[checkType] = factory
+}
+
+// Factory returns the factory registered for the given check type.
+func (r *Registry) Factory(checkType string) (Factory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	factory, ok := r.factories[checkType]
+	return factory, ok
+}
+
+// Types returns the sorted names of the registered check types.
+func (r *Registry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]string, 0, len(r.factories))
+	for checkType := range r.factories {
+		types = append(types, checkType)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// newHTTPCheck builds an httpcheck.Check from the url, timeout and expectedStatus fields.
+func newHTTPCheck(spec *Spec) (checks.Check, error) {
+	spec.Require("url")
+
+	opts := []httpcheck.Option{httpcheck.WithURL(spec.String("url"))}
+	if spec.Name != "" {
+		opts = append(opts, httpcheck.WithName(spec.Name))
+	}
+	if spec.Has("timeout") {
+		opts = append(opts, httpcheck.WithTimeout(spec.Duration("timeout")))
+	}
+	if spec.Has("expectedStatus") {
+		opts = append(opts, httpcheck.WithExpectedStatus(spec.Ints("expectedStatus")...))
+	}
+
+	return httpcheck.NewCheck(opts...), nil
+}
+
+// newTCPCheck builds a tcpcheck.Check from the host, port, network and timeout fields.
+func newTCPCheck(spec *Spec) (checks.Check, error) {
+	spec.Require("host", "port")
+
+	opts := []tcpcheck.Option{
+		tcpcheck.WithHost(spec.String("host")),
+		tcpcheck.WithPort(spec.Int("port")),
+	}
+	if spec.Name != "" {
+		opts = append(opts, tcpcheck.WithName(spec.Name))
+	}
+	if spec.Has("network") {
+		network := tcpcheck.NetworkType(spec.String("network"))
+		if network != tcpcheck.TCP && network != tcpcheck.UDP {
+			spec.Errorf("network", "field %q: expected %q or %q, got %q", "network", tcpcheck.TCP, tcpcheck.UDP, network)
+		}
+		opts = append(opts, tcpcheck.WithNetwork(network))
+	}
+	if spec.Has("timeout") {
+		opts = append(opts, tcpcheck.WithTimeout(spec.Duration("timeout")))
+	}
+
+	return tcpcheck.NewCheck(opts...), nil
+}
+
+// newDiskCheck builds a diskcheck.Check from the path and threshold fields.
+func newDiskCheck(spec *Spec) (checks.Check, error) {
+	var opts []diskcheck.Option
+	if spec.Name != "" {
+		opts = append(opts, diskcheck.WithName(spec.Name))
+	}
+	if spec.Has("path") {
+		opts = append(opts, diskcheck.WithPath(spec.String("path")))
+	}
+	if spec.Has("warn") {
+		opts = append(opts, diskcheck.WithWarnThreshold(spec.Float("warn")))
+	}
+	if spec.Has("fail") {
+		opts = append(opts, diskcheck.WithFailThreshold(spec.Float("fail")))
+	}
+	if spec.Has("warnRecovery") {
+		opts = append(opts, diskcheck.WithWarnRecoveryThreshold(spec.Float("warnRecovery")))
+	}
+	if spec.Has("failRecovery") {
+		opts = append(opts, diskcheck.WithFailRecoveryThreshold(spec.Float("failRecovery")))
+	}
+
+	return diskcheck.NewCheck(opts...), nil
+}
+
+// newMemoryCheck builds a memorycheck.Check from the threshold fields.
+func newMemoryCheck(spec *Spec) (checks.Check, error) {
+	var opts []memorycheck.Option
+	if spec.Name != "" {
+		opts = append(opts, memorycheck.WithName(spec.Name))
+	}
+	if spec.Has("warn") {
+		opts = append(opts, memorycheck.WithWarnThreshold(spec.Float("warn")))
+	}
+	if spec.Has("fail") {
+		opts = append(opts, memorycheck.WithFailThreshold(spec.Float("fail")))
+	}
+	if spec.Has("warnRecovery") {
+		opts = append(opts, memorycheck.WithWarnRecoveryThreshold(spec.Float("warnRecovery")))
+	}
+	if spec.Has("failRecovery") {
+		opts = append(opts, memorycheck.WithFailRecoveryThreshold(spec.Float("failRecovery")))
+	}
+
+	return memorycheck.NewCheck(opts...), nil
+}
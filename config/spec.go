@@ -0,0 +1,197 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Error is a configuration error, with the position of the value that caused it:
+// file:line:column for files, or the name of the environment variable.
+type Error struct {
+	Position string
+	Err      error
+}
+
+// Error returns the error message prefixed with its position.
+func (e *Error) Error() string {
+	return e.Position + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// joinErrors joins the errors into a single error, reported one per line.
+func joinErrors(errs []error) error {
+	return errors.Join(errs...)
+}
+
+// Spec is the configuration of a check, passed to the Factory registered for its type.
+// Its getters return the zero value for missing fields, and record an error with the position
+// of the field when a value cannot be parsed. Fields that are never read are reported as unknown.
+type Spec struct {
+	// Type is the type of the check.
+	Type string
+	// Name is the name of the check, or empty to use the default name of the check type.
+	Name string
+
+	obj  *node
+	used map[string]bool
+	errs []error
+}
+
+// newSpec returns a Spec reading the fields of the given object node.
+func newSpec(obj *node) *Spec {
+	return &Spec{obj: obj, used: make(map[string]bool)}
+}
+
+// Has reports whether the field is set.
+func (s *Spec) Has(key string) bool {
+	_, ok := s.obj.fields[key]
+	return ok
+}
+
+// Require records an error for each of the given fields that is not set.
+func (s *Spec) Require(keys ...string) {
+	for _, key := range keys {
+		if !s.Has(key) {
+			s.errs = append(s.errs, &Error{Position: s.obj.pos, Err: fmt.Errorf("missing required field %q", key)})
+		}
+	}
+}
+
+// Errorf records an error at the position of the given field, or of the check if the field is not set.
+func (s *Spec) Errorf(key, format string, args ...any) {
+	pos := s.obj.pos
+	if field, ok := s.obj.fields[key]; ok {
+		pos = field.pos
+	}
+	s.errs = append(s.errs, &Error{Position: pos, Err: fmt.Errorf(format, args...)})
+}
+
+// String returns the value of the field as a string.
+func (s *Spec) String(key string) string {
+	field, ok := s.scalar(key)
+	if !ok {
+		return ""
+	}
+	return field.value
+}
+
+// Int returns the value of the field as an integer.
+func (s *Spec) Int(key string) int {
+	return parseField(s, key, "an integer", strconv.Atoi)
+}
+
+// Float returns the value of the field as a floating-point number.
+func (s *Spec) Float(key string) float64 {
+	return parseField(s, key, "a number", func(value string) (float64, error) {
+		return strconv.ParseFloat(value, 64)
+	})
+}
+
+// Bool returns the value of the field as a boolean.
+func (s *Spec) Bool(key string) bool {
+	return parseField(s, key, "a boolean", strconv.ParseBool)
+}
+
+// Duration returns the value of the field as a duration, such as "5s" or "1m30s".
+func (s *Spec) Duration(key string) time.Duration {
+	return parseField(s, key, "a duration", time.ParseDuration)
+}
+
+// Strings returns the value of the field as a list of strings.
+// The field can be a list, or a comma-separated string.
+func (s *Spec) Strings(key string) []string {
+	s.used[key] = true
+
+	field, ok := s.obj.fields[key]
+	if !ok {
+		return nil
+	}
+
+	var values []string
+	switch field.kind {
+	case listNode:
+		for _, item := range field.items {
+			if item.kind != scalarNode {
+				s.errs = append(s.errs, &Error{Position: item.pos, Err: fmt.Errorf("field %q: expected a list of values", key)})
+				continue
+			}
+			values = append(values, item.value)
+		}
+	case scalarNode:
+		for _, value := range strings.Split(field.value, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	default:
+		s.errs = append(s.errs, &Error{Position: field.pos, Err: fmt.Errorf("field %q: expected a list of values", key)})
+	}
+	return values
+}
+
+// Ints returns the value of the field as a list of integers.
+// The field can be a list, or a comma-separated string.
+func (s *Spec) Ints(key string) []int {
+	field := s.obj.fields[key]
+
+	var values []int
+	for _, value := range s.Strings(key) {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			s.errs = append(s.errs, &Error{Position: field.pos, Err: fmt.Errorf("field %q: expected a list of integers, got %q", key, value)})
+			continue
+		}
+		values = append(values, i)
+	}
+	return values
+}
+
+// scalar returns the field, marking it as read, and records an error if it is not a single value.
+func (s *Spec) scalar(key string) (*node, bool) {
+	s.used[key] = true
+
+	field, ok := s.obj.fields[key]
+	if !ok {
+		return nil, false
+	}
+	if field.kind != scalarNode {
+		s.errs = append(s.errs, &Error{Position: field.pos, Err: fmt.Errorf("field %q: expected a single value", key)})
+		return nil, false
+	}
+	return field, true
+}
+
+// parseField parses the value of the field, recording an error if it cannot be parsed.
+func parseField[T any](s *Spec, key, expected string, parse func(string) (T, error)) T {
+	var zero T
+
+	field, ok := s.scalar(key)
+	if !ok {
+		return zero
+	}
+
+	value, err := parse(field.value)
+	if err != nil {
+		s.errs = append(s.errs, &Error{Position: field.pos, Err: fmt.Errorf("field %q: expected %s, got %q", key, expected, field.value)})
+		return zero
+	}
+	return value
+}
+
+// err returns the errors recorded while reading the fields, along with an error for each field never read.
+func (s *Spec) err() error {
+	errs := s.errs
+	for _, key := range s.obj.keys {
+		if !s.used[key] {
+			errs = append(errs, &Error{Position: s.obj.keyPos[key], Err: fmt.Errorf("unknown field %q", key)})
+		}
+	}
+	return joinErrors(errs)
+}
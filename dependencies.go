@@ -0,0 +1,163 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// ErrDependencyCycle is returned when registering a check whose dependencies would create a cycle.
+var ErrDependencyCycle = errors.New("healthcheck: dependency cycle")
+
+// WithCheckDependsOn declares that the check depends on the checks with the given names.
+// The check runs after its dependencies, and is skipped with a failed result if one of them fails.
+// Dependencies that are not registered, or not selected for a run, are ignored.
+func WithCheckDependsOn(names ...string) CheckOption {
+	return func(c *checkConfig) {
+		c.dependsOn = append(c.dependsOn, names...)
+	}
+}
+
+// checkDependencies verifies that registering a check with the given dependencies does not create a cycle.
+func (h *HealthCheck) checkDependencies(name string, dependsOn []string) error {
+	visited := make(map[string]bool)
+
+	var path []string
+	var reaches func(current string) bool
+	reaches = func(current string) bool {
+		path = append(path, current)
+		if current == name {
+			return true
+		}
+		if visited[current] {
+			path = path[:len(path)-1]
+			return false
+		}
+		visited[current] = true
+
+		if cfg, ok := h.checkConfigs[current]; ok {
+			for _, dep := range cfg.dependsOn {
+				if reaches(dep) {
+					return true
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		return false
+	}
+
+	for _, dep := range dependsOn {
+		if reaches(dep) {
+			return fmt.Errorf("%w: %s -> %s", ErrDependencyCycle, name, strings.Join(path, " -> "))
+		}
+	}
+
+	return nil
+}
+
+// skipResult returns a failed result for the check if one of its dependencies failed,
+// looking up the results of the dependencies with the given function. The impact of the skipped
+// result is limited to the impact of the failed dependency, so that a failing non-critical check
+// does not fail the overall status through the checks that depend on it.
+func (h *HealthCheck) skipResult(check checks.Check, resultsOf func(name string) []namedResult) (namedResult, bool) {
+	skip := namedResult{name: check.GetName()}
+	skipped := false
+
+	for _, dep := range h.config(check).dependsOn {
+		for _, cr := range resultsOf(dep) {
+			if cr.result.Status != checks.StatusFail {
+				continue
+			}
+
+			impact := h.impactOf(cr, checks.StatusFail)
+			if !skipped || impact.Severity() > skip.impact.Severity() {
+				skip.result = checks.Result{
+					Status: checks.StatusFail,
+					Output: fmt.Sprintf("skipped: dependency %s failed", dep),
+					Time:   time.Now(),
+				}
+				skip.impact = impact
+			}
+			skipped = true
+		}
+	}
+
+	return skip, skipped
+}
+
+// runOrdered runs the given checks in topological order of their dependencies: checks run concurrently
+// in waves, each wave containing the checks whose dependencies have completed. Checks with a failed
+// dependency are skipped. The returned results are in the same order as the given checks.
+func (h *HealthCheck) runOrdered(ctx context.Context, list []checks.Check) []namedResult {
+	results := make([]namedResult, len(list))
+	done := make([]bool, len(list))
+
+	pending := make(map[string]int)
+	for _, check := range list {
+		pending[check.GetName()]++
+	}
+
+	resultsOf := func(name string) []namedResult {
+		var found []namedResult
+		for i, check := range list {
+			if done[i] && check.GetName() == name {
+				found = append(found, results[i])
+			}
+		}
+		return found
+	}
+
+	for remaining := len(list); remaining > 0; {
+		var wave []int
+		for i, check := range list {
+			if !done[i] && !slices.ContainsFunc(h.config(check).dependsOn, func(dep string) bool {
+				return pending[dep] > 0
+			}) {
+				wave = append(wave, i)
+			}
+		}
+
+		// Cycles are rejected at registration, but run the remaining checks rather than stalling.
+		if len(wave) == 0 {
+			for i := range list {
+				if !done[i] {
+					wave = append(wave, i)
+				}
+			}
+		}
+
+		var runnable []checks.Check
+		var runnableIdx []int
+		skipped := make(map[int]namedResult)
+		for _, i := range wave {
+			if skip, ok := h.skipResult(list[i], resultsOf); ok {
+				skipped[i] = skip
+				continue
+			}
+			runnable = append(runnable, list[i])
+			runnableIdx = append(runnableIdx, i)
+		}
+
+		for i, skip := range skipped {
+			skip.result = h.record(list[i], skip.result)
+			results[i] = skip
+		}
+		for j, result := range h.runChecks(ctx, runnable) {
+			results[runnableIdx[j]] = result
+		}
+
+		for _, i := range wave {
+			done[i] = true
+			pending[list[i].GetName()]--
+		}
+		remaining -= len(wave)
+	}
+
+	return results
+}
@@ -0,0 +1,196 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_Dependencies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Skips Dependents Of Failed Check", func(t *testing.T) {
+		t.Parallel()
+
+		ping := mockcheck.NewCheck(mockcheck.WithName("database:ping"), mockcheck.WithStatus(checks.StatusPass))
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("tcp:database"),
+				mockcheck.WithStatus(checks.StatusFail),
+			)),
+			healthcheck.WithCheck(ping, healthcheck.WithCheckDependsOn("tcp:database")),
+		)
+		require.NoError(t, hc.Err())
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["database:ping"][0].Status)
+		assert.Equal(t, "skipped: dependency tcp:database failed", result.Checks["database:ping"][0].Output)
+		assert.Equal(t, 0, ping.Runs())
+	})
+
+	t.Run("Skips Transitive Dependents", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("connections")),
+				healthcheck.WithCheckDependsOn("ping"),
+			),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("ping")),
+				healthcheck.WithCheckDependsOn("tcp"),
+			),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("tcp"),
+				mockcheck.WithStatus(checks.StatusFail),
+			)),
+		)
+		require.NoError(t, hc.Err())
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, "skipped: dependency tcp failed", result.Checks["ping"][0].Output)
+		assert.Equal(t, "skipped: dependency ping failed", result.Checks["connections"][0].Output)
+	})
+
+	t.Run("Runs Dependents After Their Dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		dependency := mockcheck.NewCheck(mockcheck.WithName("tcp"), mockcheck.WithDelay(20*time.Millisecond))
+		dependent := mockcheck.NewCheck(mockcheck.WithName("ping"))
+		hc := newHealthTest(
+			healthcheck.WithCheck(dependent, healthcheck.WithCheckDependsOn("tcp")),
+			healthcheck.WithCheck(dependency),
+		)
+
+		start := time.Now()
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.GreaterOrEqual(t, dependent.LastRun().Sub(start), 20*time.Millisecond)
+	})
+
+	t.Run("Ignores Dependencies Not Selected For The Run", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("tcp"),
+				mockcheck.WithStatus(checks.StatusFail),
+			)),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("ping")),
+				healthcheck.WithCheckDependsOn("tcp", "unknown"),
+			),
+		)
+
+		result := hc.ExecuteFiltered(context.Background(), healthcheck.Filter{Names: []string{"ping"}})
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+	})
+
+	t.Run("Rejects Dependency Cycles", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("a")),
+				healthcheck.WithCheckDependsOn("b"),
+			),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("b")),
+				healthcheck.WithCheckDependsOn("c"),
+			),
+		)
+		require.NoError(t, hc.Err())
+
+		err := hc.AddCheck(
+			mockcheck.NewCheck(mockcheck.WithName("c")),
+			healthcheck.WithCheckDependsOn("a"),
+		)
+
+		assert.ErrorIs(t, err, healthcheck.ErrDependencyCycle)
+		assert.EqualError(t, err, "healthcheck: dependency cycle: c -> a -> b -> c")
+		assert.Len(t, hc.Checks, 2)
+	})
+
+	t.Run("Reports Cycles Registered With Options", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("a")),
+				healthcheck.WithCheckDependsOn("a"),
+			),
+		)
+
+		assert.ErrorIs(t, hc.Err(), healthcheck.ErrDependencyCycle)
+		assert.Empty(t, hc.Checks)
+	})
+
+	t.Run("Limits Skipped Dependents To Impact Of Failed Check", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("cache"),
+				mockcheck.WithStatus(checks.StatusFail),
+			), healthcheck.WithCheckNonCritical()),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("warmer")),
+				healthcheck.WithCheckDependsOn("cache"),
+			),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("preloader")),
+				healthcheck.WithCheckDependsOn("warmer"),
+			),
+		)
+		require.NoError(t, hc.Err())
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["warmer"][0].Status)
+		assert.Equal(t, "skipped: dependency warmer failed", result.Checks["preloader"][0].Output)
+
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		assert.Equal(t, checks.StatusWarn, hc.Execute(context.Background()).Status)
+	})
+
+	t.Run("Skips Dependents In Scheduler", func(t *testing.T) {
+		t.Parallel()
+
+		ping := mockcheck.NewCheck(mockcheck.WithName("ping"), mockcheck.WithStatus(checks.StatusPass))
+		hc := newHealthTest(
+			healthcheck.WithInterval(time.Hour),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("tcp"),
+				mockcheck.WithStatus(checks.StatusFail),
+			)),
+			healthcheck.WithCheck(ping,
+				healthcheck.WithCheckDependsOn("tcp"),
+				healthcheck.WithCheckInterval(10*time.Millisecond),
+			),
+		)
+
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		time.Sleep(50 * time.Millisecond)
+
+		result := hc.Execute(context.Background())
+		assert.Equal(t, "skipped: dependency tcp failed", result.Checks["ping"][0].Output)
+		assert.Equal(t, 0, ping.Runs())
+	})
+}
@@ -0,0 +1,59 @@
+package healthcheck
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// drainOutput is the output reported by the health endpoint and the readiness probe in drain mode.
+const drainOutput = "shutting down"
+
+// WithDrainDelay sets how long Drain waits after entering drain mode before returning,
+// giving load balancers time to notice the failing readiness probe (default: 0).
+func WithDrainDelay(delay time.Duration) Option {
+	return func(h *HealthCheck) {
+		h.drainDelay = delay
+	}
+}
+
+// Drain puts the HealthCheck in drain mode, typically when the service receives SIGTERM.
+// In drain mode, HealthHandler and the readiness ProbeHandler report fail with the "shutting down" output,
+// while the liveness probe keeps passing, so that traffic is drained before the process exits.
+// Drain then waits for the delay set with WithDrainDelay, and returns the context error if ctx is done first.
+// Drain mode cannot be left.
+func (h *HealthCheck) Drain(ctx context.Context) error {
+	h.draining.Store(true)
+
+	if h.drainDelay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(h.drainDelay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsDraining reports whether Drain has been called.
+func (h *HealthCheck) IsDraining() bool {
+	return h.draining.Load()
+}
+
+// writeDraining writes the failing response served in drain mode.
+func writeDraining(w http.ResponseWriter, healthchecker *HealthCheck) {
+	resp := newResponse(healthchecker, CheckRunResult{
+		Status: checks.StatusFail,
+		Checks: map[string][]checks.Result{},
+	})
+	resp.Output = drainOutput
+
+	writeResponse(w, resp)
+}
@@ -0,0 +1,83 @@
+package healthcheck_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_Drain(t *testing.T) {
+	t.Parallel()
+
+	newDrainTest := func(opts ...healthcheck.Option) *healthcheck.HealthCheck {
+		return newHealthTest(append(opts,
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("process")),
+				healthcheck.WithCheckProbes(healthcheck.ProbeLiveness, healthcheck.ProbeReadiness)),
+		)...)
+	}
+
+	serve := func(handler http.Handler) (int, healthcheck.HealthHttpResponse) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		var response healthcheck.HealthHttpResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return rr.Code, response
+	}
+
+	t.Run("Fails Readiness But Not Liveness", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newDrainTest()
+		assert.False(t, hc.IsDraining())
+		require.NoError(t, hc.Drain(context.Background()))
+		assert.True(t, hc.IsDraining())
+
+		code, response := serve(healthcheck.ProbeHandler(hc, healthcheck.ProbeReadiness))
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, checks.StatusFail, response.Status)
+		assert.Equal(t, "shutting down", response.Output)
+		assert.Equal(t, testServiceID, response.ServiceID)
+
+		code, response = serve(healthcheck.HealthHandler(hc))
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "shutting down", response.Output)
+
+		code, response = serve(healthcheck.ProbeHandler(hc, healthcheck.ProbeLiveness))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, checks.StatusPass, response.Status)
+	})
+
+	t.Run("Waits For Drain Delay", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newDrainTest(healthcheck.WithDrainDelay(50 * time.Millisecond))
+
+		start := time.Now()
+		require.NoError(t, hc.Drain(context.Background()))
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("Stops Waiting When Context Is Done", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newDrainTest(healthcheck.WithDrainDelay(time.Minute))
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := hc.Drain(ctx)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.True(t, hc.IsDraining())
+	})
+}
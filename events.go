@@ -0,0 +1,129 @@
+package healthcheck
+
+import (
+	"sync"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// StatusChange describes a transition of a check, or of the overall status, between pass, warn and fail.
+type StatusChange struct {
+	// Check is the name of the check that changed status. It is empty for the overall status.
+	Check string
+	// Previous is the last result with the previous status.
+	Previous checks.Result
+	// Current is the result with the new status.
+	Current checks.Result
+}
+
+// IsOverall reports whether the change refers to the overall status.
+func (c StatusChange) IsOverall() bool {
+	return c.Check == ""
+}
+
+// StatusListener is called when a check, or the overall status, changes status.
+type StatusListener func(change StatusChange)
+
+// WithStatusListener registers a listener that is notified of status changes.
+func WithStatusListener(listener StatusListener) Option {
+	return func(h *HealthCheck) {
+		h.OnStatusChange(listener)
+	}
+}
+
+// OnStatusChange registers a listener that is notified when a check, or the overall status,
+// moves between pass, warn and fail. The first result of a check is not reported as a change.
+// Listeners are called asynchronously, in the order the changes happened, so they never block
+// the execution of the checks.
+func (h *HealthCheck) OnStatusChange(listener StatusListener) {
+	h.tracker.mu.Lock()
+	defer h.tracker.mu.Unlock()
+
+	h.tracker.listeners = append(h.tracker.listeners, listener)
+}
+
+// observeOverall records the overall status of a run of every check.
+func (h *HealthCheck) observeOverall(result CheckRunResult) {
+	h.tracker.observe("", checks.Result{
+		Status: result.Status,
+		Output: buildOutput(result.Checks),
+		Time:   time.Now(),
+	})
+}
+
+// statusTracker keeps the last result of each check and notifies listeners of status changes.
+type statusTracker struct {
+	mu         sync.Mutex
+	listeners  []StatusListener
+	last       map[string]checks.Result
+	queue      []StatusChange
+	dispatched bool
+}
+
+// observe records the result and queues a change notification if its status differs from the last one.
+func (t *statusTracker) observe(name string, result checks.Result) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.last == nil {
+		t.last = make(map[string]checks.Result)
+	}
+
+	previous, seen := t.last[name]
+	t.last[name] = result
+
+	if !seen || previous.Status == result.Status || len(t.listeners) == 0 {
+		return
+	}
+
+	t.queue = append(t.queue, StatusChange{
+		Check:    name,
+		Previous: previous,
+		Current:  result,
+	})
+
+	if !t.dispatched {
+		t.dispatched = true
+		go t.dispatch()
+	}
+}
+
+// forget discards the last result of the check with the given name,
+// so that a check registered again with the same name starts without a previous status.
+func (t *statusTracker) forget(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.last, name)
+}
+
+// dispatch delivers the queued changes to the listeners until the queue is empty.
+func (t *statusTracker) dispatch() {
+	for {
+		t.mu.Lock()
+		if len(t.queue) == 0 {
+			t.dispatched = false
+			t.mu.Unlock()
+			return
+		}
+
+		change := t.queue[0]
+		t.queue = t.queue[1:]
+		listeners := t.listeners
+		t.mu.Unlock()
+
+		for _, listener := range listeners {
+			notify(listener, change)
+		}
+	}
+}
+
+// notify calls the listener, recovering from any panic so that one listener cannot break the others.
+func notify(listener StatusListener, change StatusChange) {
+	defer func() {
+		_ = recover()
+	}()
+
+	listener(change)
+}
@@ -0,0 +1,127 @@
+package healthcheck_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+// changeRecorder is a test status listener that records the changes it receives.
+type changeRecorder struct {
+	mu      sync.Mutex
+	changes []healthcheck.StatusChange
+}
+
+func (r *changeRecorder) listen(change healthcheck.StatusChange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, change)
+}
+
+func (r *changeRecorder) get() []healthcheck.StatusChange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]healthcheck.StatusChange(nil), r.changes...)
+}
+
+func TestHealthCheck_OnStatusChange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Notifies Check And Overall Changes", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &changeRecorder{}
+		check := mockcheck.NewCheck(
+			mockcheck.WithName("database"),
+			mockcheck.WithStatuses(checks.StatusPass, checks.StatusFail, checks.StatusFail, checks.StatusWarn),
+		)
+		hc := newHealthTest(
+			healthcheck.WithStatusListener(recorder.listen),
+			healthcheck.WithCheck(check),
+		)
+
+		for range 4 {
+			hc.Execute(context.Background())
+		}
+
+		require.Eventually(t, func() bool {
+			return len(recorder.get()) == 4
+		}, time.Second, 5*time.Millisecond)
+
+		changes := recorder.get()
+		assert.Equal(t, "database", changes[0].Check)
+		assert.Equal(t, checks.StatusPass, changes[0].Previous.Status)
+		assert.Equal(t, checks.StatusFail, changes[0].Current.Status)
+
+		assert.True(t, changes[1].IsOverall())
+		assert.Equal(t, checks.StatusPass, changes[1].Previous.Status)
+		assert.Equal(t, checks.StatusFail, changes[1].Current.Status)
+
+		assert.Equal(t, "database", changes[2].Check)
+		assert.Equal(t, checks.StatusWarn, changes[2].Current.Status)
+
+		assert.True(t, changes[3].IsOverall())
+		assert.Equal(t, checks.StatusWarn, changes[3].Current.Status)
+	})
+
+	t.Run("Does Not Block Execution", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan struct{})
+		defer close(release)
+
+		check := mockcheck.NewCheck(
+			mockcheck.WithName("database"),
+			mockcheck.WithStatuses(checks.StatusPass, checks.StatusFail, checks.StatusPass, checks.StatusFail),
+		)
+		hc := newHealthTest(healthcheck.WithCheck(check))
+		hc.OnStatusChange(func(change healthcheck.StatusChange) {
+			<-release
+		})
+
+		hc.Execute(context.Background())
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for range 3 {
+				hc.Execute(context.Background())
+			}
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("execution blocked by status listener")
+		}
+	})
+
+	t.Run("Notifies Changes Detected By Scheduler", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &changeRecorder{}
+		check := mockcheck.NewCheck(
+			mockcheck.WithName("flaky"),
+			mockcheck.WithStatuses(checks.StatusFail, checks.StatusPass),
+		)
+		hc := newHealthTest(
+			healthcheck.WithStatusListener(recorder.listen),
+			healthcheck.WithCheck(check, healthcheck.WithCheckInterval(10*time.Millisecond)),
+		)
+
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		assert.Eventually(t, func() bool {
+			return len(recorder.get()) >= 2
+		}, time.Second, 5*time.Millisecond)
+	})
+}
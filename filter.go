@@ -0,0 +1,70 @@
+package healthcheck
+
+import (
+	"context"
+	"slices"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// WithCheckTags attaches free-form tags to the check, such as "database" or "external",
+// that can be used to run a subset of the checks with ExecuteFiltered.
+func WithCheckTags(tags ...string) CheckOption {
+	return func(c *checkConfig) {
+		c.tags = append(c.tags, tags...)
+	}
+}
+
+// Filter selects a subset of the registered checks.
+type Filter struct {
+	// Tags selects the checks that have at least one of the given tags.
+	Tags []string
+	// Names selects the checks with one of the given names.
+	Names []string
+}
+
+// IsEmpty reports whether the filter selects every check.
+func (f Filter) IsEmpty() bool {
+	return len(f.Tags) == 0 && len(f.Names) == 0
+}
+
+// Matches reports whether the check with the given name and tags is selected by the filter.
+func (f Filter) Matches(name string, tags []string) bool {
+	if len(f.Names) > 0 && !slices.Contains(f.Names, name) {
+		return false
+	}
+
+	if len(f.Tags) > 0 && !slices.ContainsFunc(tags, func(tag string) bool {
+		return slices.Contains(f.Tags, tag)
+	}) {
+		return false
+	}
+
+	return true
+}
+
+// CheckTags returns the sorted, unique tags of the registered checks.
+func (h *HealthCheck) CheckTags() []string {
+	h.checksMu.RLock()
+	defer h.checksMu.RUnlock()
+
+	var tags []string
+	for _, cfg := range h.checkConfigs {
+		tags = append(tags, cfg.tags...)
+	}
+	slices.Sort(tags)
+	return slices.Compact(tags)
+}
+
+// ExecuteFiltered runs the checks selected by the filter and returns an aggregated result,
+// with the overall status computed from the selected checks only. The override of the overall status
+// is not applied to a filtered run. An empty filter runs every check, like Execute.
+func (h *HealthCheck) ExecuteFiltered(ctx context.Context, filter Filter) CheckRunResult {
+	if filter.IsEmpty() {
+		return h.Execute(ctx)
+	}
+
+	return h.execute(ctx, func(check checks.Check) bool {
+		return filter.Matches(check.GetName(), h.config(check).tags)
+	}, false)
+}
@@ -0,0 +1,78 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func newTaggedHealthTest() *healthcheck.HealthCheck {
+	return newHealthTest(
+		healthcheck.WithCheck(
+			mockcheck.NewCheck(mockcheck.WithName("postgres"), mockcheck.WithStatus(checks.StatusFail)),
+			healthcheck.WithCheckTags("database", "critical-path"),
+		),
+		healthcheck.WithCheck(
+			mockcheck.NewCheck(mockcheck.WithName("redis"), mockcheck.WithStatus(checks.StatusWarn)),
+			healthcheck.WithCheckTags("database"),
+		),
+		healthcheck.WithCheck(
+			mockcheck.NewCheck(mockcheck.WithName("payments-api")),
+			healthcheck.WithCheckTags("external"),
+		),
+	)
+}
+
+func TestHealthCheck_ExecuteFiltered(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Runs Every Check With Empty Filter", func(t *testing.T) {
+		t.Parallel()
+
+		result := newTaggedHealthTest().ExecuteFiltered(context.Background(), healthcheck.Filter{})
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Len(t, result.Checks, 3)
+	})
+
+	t.Run("Runs Checks With Any Of The Tags", func(t *testing.T) {
+		t.Parallel()
+
+		result := newTaggedHealthTest().ExecuteFiltered(context.Background(), healthcheck.Filter{
+			Tags: []string{"critical-path", "external"},
+		})
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Len(t, result.Checks, 2)
+		assert.Contains(t, result.Checks, "postgres")
+		assert.Contains(t, result.Checks, "payments-api")
+	})
+
+	t.Run("Runs Checks By Name", func(t *testing.T) {
+		t.Parallel()
+
+		result := newTaggedHealthTest().ExecuteFiltered(context.Background(), healthcheck.Filter{
+			Names: []string{"redis"},
+		})
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Len(t, result.Checks, 1)
+	})
+
+	t.Run("Combines Tags And Names", func(t *testing.T) {
+		t.Parallel()
+
+		result := newTaggedHealthTest().ExecuteFiltered(context.Background(), healthcheck.Filter{
+			Tags:  []string{"database"},
+			Names: []string{"redis", "payments-api"},
+		})
+
+		assert.Len(t, result.Checks, 1)
+		assert.Contains(t, result.Checks, "redis")
+	})
+}
@@ -2,7 +2,10 @@ package healthcheck
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/brpaz/go-healthcheck/v2/checks"
@@ -14,9 +17,13 @@ type HealthHttpResponse struct {
 	Description string                     `json:"description,omitempty"`
 	Version     string                     `json:"version,omitempty"`
 	ReleaseID   string                     `json:"releaseId,omitempty"`
+	Notes       []string                   `json:"notes,omitempty"`
 	Output      string                     `json:"output,omitempty"`
 	Status      checks.Status              `json:"status"`
 	Checks      map[string][]checks.Result `json:"checks"`
+	Links       map[string]string          `json:"links,omitempty"`
+	Overrides   []Override                 `json:"overrides,omitempty"`
+	History     map[string]CheckHistory    `json:"history,omitempty"`
 }
 
 func buildOutput(checks map[string][]checks.Result) string {
@@ -32,30 +39,218 @@ func buildOutput(checks map[string][]checks.Result) string {
 }
 
 // HealthHandler provides an HTTP handler that can be used to serve the health check endpoint.
+// The "tag" and "check" query parameters restrict the run to the checks with the given tags or names.
+// Both parameters can be repeated or contain comma-separated values.
+// Tags and names that match no registered check, or filters selecting no check, result in a 404 response
+// listing the valid check names and tags.
+// The "history=true" query parameter adds the history of the checks to the response, see WithHistorySize.
+// In drain mode, it fails without running the checks.
 func HealthHandler(healthchecker *HealthCheck) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
-		w.Header().Set("Content-Type", "application/health+json")
+		if healthchecker.IsDraining() {
+			writeDraining(w, healthchecker)
+			return
+		}
+
+		result, ok := executeQuery(w, r, healthchecker)
+		if !ok {
+			return
+		}
+
+		writeResponse(w, newRequestResponse(r, healthchecker, result))
+	}
+}
+
+// executeQuery runs the checks selected by the "tag" and "check" query parameters. If the filter
+// has a value matching no registered check, or selects no check, it writes a 404 response and returns false.
+func executeQuery(w http.ResponseWriter, r *http.Request, healthchecker *HealthCheck) (CheckRunResult, bool) {
+	filter := filterFromQuery(r)
+	if unknown := unknownFilterValues(healthchecker, filter); unknown != "" {
+		writeNotFound(w, healthchecker, unknown+" not found")
+		return CheckRunResult{}, false
+	}
+
+	result := healthchecker.ExecuteFiltered(r.Context(), filter)
+	if len(result.Checks) == 0 && !filter.IsEmpty() {
+		writeNotFound(w, healthchecker, "no check matches the filter")
+		return CheckRunResult{}, false
+	}
+
+	return result, true
+}
+
+// unknownFilterValues describes the tags and names of the filter that match no registered check,
+// or returns an empty string if every value matches a check.
+func unknownFilterValues(healthchecker *HealthCheck, filter Filter) string {
+	var unknown []string
+
+	names := healthchecker.CheckNames()
+	for _, name := range filter.Names {
+		if !slices.Contains(names, name) {
+			unknown = append(unknown, fmt.Sprintf("check %q", name))
+		}
+	}
 
-		result := healthchecker.Execute(ctx)
+	tags := healthchecker.CheckTags()
+	for _, tag := range filter.Tags {
+		if !slices.Contains(tags, tag) {
+			unknown = append(unknown, fmt.Sprintf("tag %q", tag))
+		}
+	}
+
+	return strings.Join(unknown, ", ")
+}
+
+// writeNotFound writes a 404 response with the given error, listing the valid check names and tags.
+func writeNotFound(w http.ResponseWriter, healthchecker *HealthCheck, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(checkNotFoundResponse{
+		Error:       message,
+		ValidChecks: healthchecker.CheckNames(),
+		ValidTags:   healthchecker.CheckTags(),
+	})
+}
+
+// newRequestResponse maps the check run result to the HTTP response structure,
+// adding the history of the checks if requested by the "history" query parameter.
+func newRequestResponse(r *http.Request, healthchecker *HealthCheck, result CheckRunResult) HealthHttpResponse {
+	resp := newResponse(healthchecker, result)
+	if history, _ := strconv.ParseBool(r.URL.Query().Get("history")); history {
+		resp.History = healthchecker.historyOf(result)
+	}
+	return resp
+}
+
+// filterFromQuery builds a Filter from the "tag" and "check" query parameters.
+func filterFromQuery(r *http.Request) Filter {
+	query := r.URL.Query()
+	return Filter{
+		Tags:  splitQueryValues(query["tag"]),
+		Names: splitQueryValues(query["check"]),
+	}
+}
 
-		// Map to HTTP response structure
-		resp := HealthHttpResponse{
-			ServiceID:   healthchecker.ServiceID,
-			Description: healthchecker.Description,
-			Version:     healthchecker.Version,
-			ReleaseID:   healthchecker.ReleaseID,
-			Status:      result.Status,
-			Checks:      result.Checks,
-			Output:      buildOutput(result.Checks),
+// splitQueryValues splits comma-separated query values, dropping empty entries.
+func splitQueryValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
 		}
+	}
+	return result
+}
 
-		if result.Status == checks.StatusFail {
-			w.WriteHeader(http.StatusServiceUnavailable)
-		} else {
-			w.WriteHeader(http.StatusOK)
+// ProbeHandler provides an HTTP handler that serves a probe endpoint, such as /livez, /readyz or /startupz,
+// running only the checks registered for the given probe.
+// In drain mode, the readiness probe fails without running the checks, while the other probes are unaffected.
+func ProbeHandler(healthchecker *HealthCheck, probe Probe) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if probe == ProbeReadiness && healthchecker.IsDraining() {
+			writeDraining(w, healthchecker)
+			return
 		}
 
-		_ = json.NewEncoder(w).Encode(resp)
+		result := healthchecker.ExecuteProbe(r.Context(), probe)
+		writeResult(w, healthchecker, result)
 	}
 }
+
+// CheckHandler provides an HTTP handler that runs a single registered check, selected by the
+// "checkName" path value, and derives the status and status code from that check alone:
+// an override of the check applies, but not its impact on the overall status nor the overall override.
+// Unknown check names result in a 404 response listing the valid check names.
+// Like HealthHandler, it supports the "history=true" query parameter.
+func CheckHandler(healthchecker *HealthCheck) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue(checkNamePathValue)
+		if !healthchecker.HasCheck(name) {
+			writeNotFound(w, healthchecker, fmt.Sprintf("check %q not found", name))
+			return
+		}
+
+		result := healthchecker.ExecuteFiltered(r.Context(), Filter{Names: []string{name}})
+		result.Status = worstStatus(result.Checks[name])
+		writeResponse(w, newRequestResponse(r, healthchecker, result))
+	}
+}
+
+// RegisterRoutes mounts the health endpoint at path, and the per-check endpoints at path/{checkName}, on the given mux.
+// When path is "/", the health endpoint is served at the root only, and every other path is handled as a check name.
+func RegisterRoutes(mux *http.ServeMux, path string, healthchecker *HealthCheck) {
+	path = strings.TrimSuffix(path, "/")
+
+	healthPattern := path
+	if path == "" {
+		// An empty pattern is invalid, and "/" would match every path.
+		healthPattern = "/{$}"
+	}
+
+	mux.Handle(healthPattern, HealthHandler(healthchecker))
+	mux.Handle(path+"/{"+checkNamePathValue+"...}", CheckHandler(healthchecker))
+}
+
+// worstStatus returns the least healthy status of the results, or StatusPass if there are none.
+func worstStatus(results []checks.Result) checks.Status {
+	status := checks.StatusPass
+	for _, result := range results {
+		if result.Status.Severity() > status.Severity() {
+			status = result.Status
+		}
+	}
+	return status
+}
+
+// checkNamePathValue is the name of the path value holding the check name in per-check endpoints.
+const checkNamePathValue = "checkName"
+
+// checkNotFoundResponse is the body returned when a request targets unknown checks or tags.
+type checkNotFoundResponse struct {
+	Error       string   `json:"error"`
+	ValidChecks []string `json:"validChecks"`
+	ValidTags   []string `json:"validTags,omitempty"`
+}
+
+// writeResult writes the check run result as an application/health+json response.
+func writeResult(w http.ResponseWriter, healthchecker *HealthCheck, result CheckRunResult) {
+	writeResponse(w, newResponse(healthchecker, result))
+}
+
+// newResponse maps the check run result to the HTTP response structure.
+func newResponse(healthchecker *HealthCheck, result CheckRunResult) HealthHttpResponse {
+	resp := HealthHttpResponse{
+		ServiceID:   healthchecker.ServiceID,
+		Description: healthchecker.Description,
+		Version:     healthchecker.Version,
+		ReleaseID:   healthchecker.ReleaseID,
+		Notes:       healthchecker.Notes,
+		Links:       healthchecker.Links,
+		Status:      result.Status,
+		Checks:      result.Checks,
+		Output:      buildOutput(result.Checks),
+		Overrides:   result.Overrides,
+	}
+
+	// Make an override of the overall status stand out in the output.
+	if len(result.Overrides) > 0 && result.Overrides[0].IsOverall() {
+		resp.Output = strings.TrimSuffix(result.Overrides[0].describe()+"; "+resp.Output, "; ")
+	}
+
+	return resp
+}
+
+// writeResponse writes the response as application/health+json, with a 503 status code if it failed.
+func writeResponse(w http.ResponseWriter, resp HealthHttpResponse) {
+	w.Header().Set("Content-Type", "application/health+json")
+
+	if resp.Status == checks.StatusFail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/brpaz/go-healthcheck/v2"
 	"github.com/brpaz/go-healthcheck/v2/checks"
@@ -96,4 +97,242 @@ func TestHandler(t *testing.T) {
 		assert.NotEmpty(t, checkResult)
 		assert.Equal(t, checks.StatusFail, checkResult[0].Status)
 	})
+	t.Run("Filtered Health Check", func(t *testing.T) {
+		t.Parallel()
+
+		hc := healthcheck.New(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("postgres"), mockcheck.WithStatus(checks.StatusFail)),
+				healthcheck.WithCheckTags("database"),
+			),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("redis"), mockcheck.WithStatus(checks.StatusWarn)),
+				healthcheck.WithCheckTags("cache"),
+			),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("payments-api")),
+				healthcheck.WithCheckTags("external"),
+			),
+		)
+
+		req, _ := http.NewRequest("GET", "/health?tag=cache,external&check=redis&check=payments-api", nil)
+		rr := httptest.NewRecorder()
+
+		healthcheck.HealthHandler(hc).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response healthcheck.HealthHttpResponse
+		err := json.Unmarshal(rr.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, checks.StatusWarn, response.Status)
+		assert.Len(t, response.Checks, 2)
+		assert.NotContains(t, response.Checks, "postgres")
+	})
+	t.Run("Filter Matching No Check", func(t *testing.T) {
+		t.Parallel()
+
+		hc := healthcheck.New(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("postgres")),
+				healthcheck.WithCheckTags("database"),
+			),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("redis")),
+				healthcheck.WithCheckTags("cache", "database"),
+			),
+		)
+
+		tests := []struct {
+			query     string
+			wantError string
+		}{
+			{query: "tag=dbb", wantError: `tag "dbb" not found`},
+			{query: "tag=database&check=redis&check=redsi", wantError: `check "redsi" not found`},
+			{query: "tag=cache,dbb&check=mysql", wantError: `check "mysql", tag "dbb" not found`},
+			{query: "tag=cache&check=postgres", wantError: "no check matches the filter"},
+		}
+
+		for _, tt := range tests {
+			req, _ := http.NewRequest("GET", "/health?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			healthcheck.HealthHandler(hc).ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusNotFound, rr.Code, tt.query)
+
+			var response struct {
+				Error       string   `json:"error"`
+				ValidChecks []string `json:"validChecks"`
+				ValidTags   []string `json:"validTags"`
+			}
+			assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantError, response.Error)
+			assert.Equal(t, []string{"postgres", "redis"}, response.ValidChecks)
+			assert.Equal(t, []string{"cache", "database"}, response.ValidTags)
+		}
+	})
+}
+
+func TestCheckHandler(t *testing.T) {
+	t.Parallel()
+
+	hc := healthcheck.New(
+		healthcheck.WithCheck(mockcheck.NewCheck(
+			mockcheck.WithName("database:ping"),
+			mockcheck.WithStatus(checks.StatusFail),
+		)),
+		healthcheck.WithCheck(mockcheck.NewCheck(
+			mockcheck.WithName("redis"),
+			mockcheck.WithStatus(checks.StatusPass),
+		)),
+	)
+
+	mux := http.NewServeMux()
+	healthcheck.RegisterRoutes(mux, "/health", hc)
+
+	t.Run("Serves Health Endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/health", nil)
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+
+	t.Run("Runs Single Passing Check", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/health/redis", nil)
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/health+json", rr.Header().Get("Content-Type"))
+
+		var response healthcheck.HealthHttpResponse
+		err := json.Unmarshal(rr.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, checks.StatusPass, response.Status)
+		assert.Len(t, response.Checks, 1)
+		assert.Contains(t, response.Checks, "redis")
+	})
+
+	t.Run("Runs Single Failing Check", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/health/database:ping", nil)
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+
+	t.Run("Reports Status Of The Check Alone", func(t *testing.T) {
+		t.Parallel()
+
+		hc := healthcheck.New(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("cache"), mockcheck.WithStatus(checks.StatusFail)),
+				healthcheck.WithCheckNonCritical(),
+			),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("search"), mockcheck.WithStatus(checks.StatusFail))),
+		)
+		require.NoError(t, hc.SetOverride(healthcheck.Override{Status: checks.StatusWarn, Reason: "maintenance"}))
+		require.NoError(t, hc.SetOverride(healthcheck.Override{Check: "search", Status: checks.StatusWarn, Reason: "reindexing"}))
+
+		mux := http.NewServeMux()
+		healthcheck.RegisterRoutes(mux, "/health", hc)
+
+		for path, want := range map[string]struct {
+			code   int
+			status checks.Status
+		}{
+			"/health/cache":  {code: http.StatusServiceUnavailable, status: checks.StatusFail},
+			"/health/search": {code: http.StatusOK, status: checks.StatusWarn},
+		} {
+			req, _ := http.NewRequest("GET", path, nil)
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+
+			assert.Equal(t, want.code, rr.Code, path)
+
+			var response healthcheck.HealthHttpResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, want.status, response.Status, path)
+		}
+	})
+
+	t.Run("Returns Not Found For Unknown Check", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/health/unknown", nil)
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+
+		var response struct {
+			Error       string   `json:"error"`
+			ValidChecks []string `json:"validChecks"`
+		}
+		err := json.Unmarshal(rr.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, `check "unknown" not found`, response.Error)
+		assert.Equal(t, []string{"database:ping", "redis"}, response.ValidChecks)
+	})
+}
+
+func TestRegisterRoutes(t *testing.T) {
+	t.Parallel()
+
+	hc := healthcheck.New(
+		healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("redis"))),
+	)
+
+	mux := http.NewServeMux()
+	require.NotPanics(t, func() {
+		healthcheck.RegisterRoutes(mux, "/", hc)
+	})
+
+	for path, wantCode := range map[string]int{
+		"/":        http.StatusOK,
+		"/redis":   http.StatusOK,
+		"/unknown": http.StatusNotFound,
+	} {
+		req, _ := http.NewRequest("GET", path, nil)
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		assert.Equal(t, wantCode, rr.Code, path)
+	}
+}
+
+func TestHandler_RFCFields(t *testing.T) {
+	t.Parallel()
+
+	hc := healthcheck.New(
+		healthcheck.WithServiceID(testServiceID),
+		healthcheck.WithNotes("running in eu-west-1"),
+		healthcheck.WithLink("about", "http://api.example.com/about"),
+		healthcheck.WithCheck(
+			mockcheck.NewCheck(mockcheck.WithName(testCheckName)),
+			healthcheck.WithCheckComponentType(checks.ComponentTypeComponent),
+		),
+	)
+
+	req, _ := http.NewRequest("GET", "/health", nil)
+	rr := httptest.NewRecorder()
+	healthcheck.HealthHandler(hc).ServeHTTP(rr, req)
+
+	var body map[string]any
+	err := json.Unmarshal(rr.Body.Bytes(), &body)
+	assert.NoError(t, err)
+	assert.Equal(t, testServiceID, body["serviceId"])
+	assert.Equal(t, []any{"running in eu-west-1"}, body["notes"])
+	assert.Equal(t, map[string]any{"about": "http://api.example.com/about"}, body["links"])
+
+	checkResults := body["checks"].(map[string]any)[testCheckName].([]any)
+	assert.Equal(t, "component", checkResults[0].(map[string]any)["componentType"])
 }
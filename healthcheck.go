@@ -28,10 +28,23 @@ package healthcheck
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/brpaz/go-healthcheck/v2/checks"
 )
 
+var (
+	// ErrUnknownCheck is returned when referring to a check that is not registered.
+	ErrUnknownCheck = errors.New("healthcheck: unknown check")
+	// ErrDuplicateCheck is returned when registering a check with the name of a registered check.
+	ErrDuplicateCheck = errors.New("healthcheck: duplicate check")
+)
+
 type HealthChecker interface {
 	Execute(ctx context.Context) CheckRunResult
 }
@@ -42,7 +55,30 @@ type HealthCheck struct {
 	Description string
 	Version     string
 	ReleaseID   string
-	Checks      []checks.Check
+	Notes       []string
+	Links       map[string]string
+	// Checks holds the registered checks. Use AddCheck, RemoveCheck and ReplaceCheck to change them
+	// while the HealthCheck is in use.
+	Checks []checks.Check
+
+	interval      time.Duration
+	timeout       time.Duration
+	timeoutStatus checks.Status
+	panicHook     PanicHook
+	panicStack    bool
+	checkConfigs  map[string]*checkConfig
+	checksMu      sync.RWMutex
+	scheduler     *scheduler
+	startupResult *CheckRunResult
+	tracker       statusTracker
+	overrides     overrideStore
+	history       historyStore
+	drainDelay    time.Duration
+	draining      atomic.Bool
+	rejected      []rejectedCheck
+	limiter       chan struct{}
+	groupLimiters map[string]chan struct{}
+	mu            sync.Mutex
 }
 
 // Option is a functional option for configuring HealthCheck.
@@ -77,16 +113,85 @@ func WithReleaseID(id string) Option {
 }
 
 // WithCheck registers a check in the HealthCheck.
-func WithCheck(check checks.Check) Option {
+// Optional CheckOption values customize how the check is executed.
+// A check that cannot be registered, such as a second check with the same name, is not run: the error
+// is reported by Err, and fails the overall status with a result under the name of the check.
+func WithCheck(check checks.Check, opts ...CheckOption) Option {
 	return func(h *HealthCheck) {
-		h.Checks = append(h.Checks, check)
+		if err := h.AddCheck(check, opts...); err != nil {
+			h.rejected = append(h.rejected, rejectedCheck{name: check.GetName(), err: err})
+		}
+	}
+}
+
+// rejectedCheck is a check that WithCheck could not register.
+type rejectedCheck struct {
+	name string
+	err  error
+}
+
+// result returns the failed result reporting why the check was not registered.
+func (r rejectedCheck) result() checks.Result {
+	return checks.Result{
+		Status: checks.StatusFail,
+		Output: "not registered: " + r.err.Error(),
+		Time:   time.Now(),
+	}
+}
+
+// checkConfig holds the execution settings of a registered check.
+type checkConfig struct {
+	interval  time.Duration
+	timeout   time.Duration
+	impact    checks.Status
+	probes    []Probe
+	tags      []string
+	component componentConfig
+	dependsOn []string
+	group     string
+}
+
+// CheckOption is a functional option for configuring how a registered check is executed.
+type CheckOption func(*checkConfig)
+
+// WithCheckInterval overrides the interval at which the background scheduler runs the check.
+func WithCheckInterval(interval time.Duration) CheckOption {
+	return func(c *checkConfig) {
+		c.interval = interval
+	}
+}
+
+// WithCheckTimeout sets the maximum time the check is allowed to run.
+// A check that overruns its timeout is reported with the HealthCheck timeout status.
+func WithCheckTimeout(timeout time.Duration) CheckOption {
+	return func(c *checkConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithCheckImpact limits the impact of the check on the overall status to the given status.
+// For example, with StatusWarn a failing check makes the overall status warn instead of fail,
+// and with StatusPass the check does not affect the overall status at all.
+// The individual result of the check is reported unchanged.
+func WithCheckImpact(status checks.Status) CheckOption {
+	return func(c *checkConfig) {
+		c.impact = status
 	}
 }
 
+// WithCheckNonCritical marks the check as non-critical: a failure downgrades the overall status
+// to warn instead of fail. It is a shorthand for WithCheckImpact(checks.StatusWarn).
+func WithCheckNonCritical() CheckOption {
+	return WithCheckImpact(checks.StatusWarn)
+}
+
 // New creates a new HealthChecker instance the provided options.
 func New(opts ...Option) *HealthCheck {
 	h := &HealthCheck{
-		Checks: make([]checks.Check, 0),
+		Checks:        make([]checks.Check, 0),
+		interval:      defaultInterval,
+		timeoutStatus: checks.StatusFail,
+		checkConfigs:  make(map[string]*checkConfig),
 	}
 
 	for _, opt := range opts {
@@ -96,63 +201,319 @@ func New(opts ...Option) *HealthCheck {
 	return h
 }
 
-// AddCheck adds a new check to the HealthCheck instance.
-func (h *HealthCheck) AddCheck(check checks.Check) {
+// AddCheck adds a new check to the HealthCheck instance. It is safe to call while checks are running.
+// If the background scheduler is running, the check is scheduled right away.
+// It returns ErrDuplicateCheck if a check with the same name is already registered,
+// and ErrDependencyCycle if the dependencies of the check would create a cycle.
+func (h *HealthCheck) AddCheck(check checks.Check, opts ...CheckOption) error {
+	cfg := newCheckConfig(opts)
+
+	h.checksMu.Lock()
+	if h.indexOf(check.GetName()) >= 0 {
+		h.checksMu.Unlock()
+		return fmt.Errorf("%w: %q", ErrDuplicateCheck, check.GetName())
+	}
+	if err := h.checkDependencies(check.GetName(), cfg.dependsOn); err != nil {
+		h.checksMu.Unlock()
+		return err
+	}
+
+	if h.checkConfigs == nil {
+		h.checkConfigs = make(map[string]*checkConfig)
+	}
+	h.checkConfigs[check.GetName()] = cfg
 	h.Checks = append(h.Checks, check)
+	h.checksMu.Unlock()
+
+	h.scheduleCheck(check)
+
+	return nil
+}
+
+// RemoveCheck unregisters the check with the given name. It is safe to call while checks are running.
+// If the background scheduler is running, the check stops being scheduled.
+// The override, history and status tracking of the check are discarded.
+// It returns ErrUnknownCheck if no check with the given name is registered.
+func (h *HealthCheck) RemoveCheck(name string) error {
+	h.checksMu.Lock()
+	i := h.indexOf(name)
+	if i < 0 {
+		h.checksMu.Unlock()
+		return fmt.Errorf("%w: %q", ErrUnknownCheck, name)
+	}
+
+	h.Checks = slices.Delete(slices.Clone(h.Checks), i, i+1)
+	delete(h.checkConfigs, name)
+	h.checksMu.Unlock()
+
+	h.unscheduleCheck(name)
+	h.ClearOverride(name)
+	h.history.remove(name)
+	h.tracker.forget(name)
+
+	return nil
+}
+
+// ReplaceCheck replaces the registered check with the same name, and its options.
+// It is safe to call while checks are running. The check keeps its position, override and history.
+// If the background scheduler is running, the new check is scheduled right away.
+// It returns ErrUnknownCheck if no check with the same name is registered,
+// and ErrDependencyCycle if the dependencies of the check would create a cycle.
+func (h *HealthCheck) ReplaceCheck(check checks.Check, opts ...CheckOption) error {
+	cfg := newCheckConfig(opts)
+
+	h.checksMu.Lock()
+	i := h.indexOf(check.GetName())
+	if i < 0 {
+		h.checksMu.Unlock()
+		return fmt.Errorf("%w: %q", ErrUnknownCheck, check.GetName())
+	}
+	if err := h.checkDependencies(check.GetName(), cfg.dependsOn); err != nil {
+		h.checksMu.Unlock()
+		return err
+	}
+
+	h.Checks = slices.Clone(h.Checks)
+	h.Checks[i] = check
+	h.checkConfigs[check.GetName()] = cfg
+	h.checksMu.Unlock()
+
+	h.unscheduleCheck(check.GetName())
+	h.scheduleCheck(check)
+
+	return nil
+}
+
+// newCheckConfig builds the execution settings of a check from its options.
+func newCheckConfig(opts []CheckOption) *checkConfig {
+	cfg := &checkConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// indexOf returns the position of the check with the given name, or -1 if it is not registered.
+// The caller must hold checksMu.
+func (h *HealthCheck) indexOf(name string) int {
+	return slices.IndexFunc(h.Checks, func(check checks.Check) bool {
+		return check.GetName() == name
+	})
+}
+
+// Err returns the errors that occurred while registering checks with WithCheck, if any.
+func (h *HealthCheck) Err() error {
+	errs := make([]error, 0, len(h.rejected))
+	for _, rejected := range h.rejected {
+		errs = append(errs, rejected.err)
+	}
+	return errors.Join(errs...)
+}
+
+// config returns the execution settings of the given check.
+func (h *HealthCheck) config(check checks.Check) *checkConfig {
+	return h.configByName(check.GetName())
+}
+
+// configByName returns the execution settings of the check with the given name.
+func (h *HealthCheck) configByName(name string) *checkConfig {
+	h.checksMu.RLock()
+	defer h.checksMu.RUnlock()
+
+	if cfg, ok := h.checkConfigs[name]; ok {
+		return cfg
+	}
+	return &checkConfig{}
 }
 
 // GetChecks returns the registered checks.
 func (h *HealthCheck) GetChecks() []checks.Check {
+	h.checksMu.RLock()
+	defer h.checksMu.RUnlock()
+
 	return h.Checks
 }
 
+// HasCheck reports whether a check with the given name is registered.
+func (h *HealthCheck) HasCheck(name string) bool {
+	h.checksMu.RLock()
+	defer h.checksMu.RUnlock()
+
+	return h.indexOf(name) >= 0
+}
+
+// CheckNames returns the sorted names of the registered checks.
+func (h *HealthCheck) CheckNames() []string {
+	h.checksMu.RLock()
+	defer h.checksMu.RUnlock()
+
+	names := make([]string, 0, len(h.Checks))
+	for _, check := range h.Checks {
+		names = append(names, check.GetName())
+	}
+	slices.Sort(names)
+	return names
+}
+
 // CheckRunResult aggregates the result of running a group of checks.
 type CheckRunResult struct {
-	Status checks.Status
-	Checks map[string][]checks.Result
+	Status    checks.Status
+	Checks    map[string][]checks.Result
+	Overrides []Override
+}
+
+// namedResult associates a check result with the name of the check that produced it.
+type namedResult struct {
+	name   string
+	result checks.Result
+	// impact limits the impact of a skipped result on the overall status to the impact of the
+	// failed dependency, or is empty for results of checks that ran.
+	impact checks.Status
+}
+
+// impactOf returns the status that the result contributes to the overall status,
+// limited by the impact of the check and by the impact of a failed dependency.
+func (h *HealthCheck) impactOf(cr namedResult, status checks.Status) checks.Status {
+	for _, limit := range []checks.Status{h.configByName(cr.name).impact, cr.impact} {
+		if limit != "" && status.Severity() > limit.Severity() {
+			status = limit
+		}
+	}
+	return status
 }
 
 // Execute runs all registered healthchecks and returns an aggregated result, composed of the
 // overall status and the individual results of each check.
+// When the background scheduler is running, the latest cached results are returned instead.
 // The final status is determined as follows:
 // - If any check returns StatusFail, the overall status is StatusFail.
 // - If no checks return StatusFail but at least one returns StatusWarn, the overall status is StatusWarn.
 // - If all checks return StatusPass, the overall status is StatusPass.
+// Checks registered with WithCheckDependsOn run after their dependencies, and are skipped if a dependency fails.
+// Checks registered with WithCheckImpact or WithCheckNonCritical contribute at most their configured status.
+// An override of the overall status set with SetOverride replaces the final status.
 func (h *HealthCheck) Execute(ctx context.Context) CheckRunResult {
+	return h.execute(ctx, nil, true)
+}
+
+// execute runs the registered checks accepted by the include function, or all of them if include is nil,
+// and aggregates their results, applying the override of the overall status if overall is set.
+func (h *HealthCheck) execute(ctx context.Context, include func(checks.Check) bool, overall bool) CheckRunResult {
+	ctx, cancel := h.withDeadline(ctx)
+	defer cancel()
+
+	results, ok := h.snapshot(ctx, include)
+	if !ok {
+		results = h.runOrdered(ctx, filterChecks(h.GetChecks(), include))
+	}
+
+	result := h.aggregate(results, overall)
+	if include == nil {
+		h.observeOverall(result)
+	}
+
+	return result
+}
+
+// filterChecks returns the checks accepted by the include function, or all of them if include is nil.
+func filterChecks(list []checks.Check, include func(checks.Check) bool) []checks.Check {
+	if include == nil {
+		return list
+	}
+
+	filtered := make([]checks.Check, 0, len(list))
+	for _, check := range list {
+		if include(check) {
+			filtered = append(filtered, check)
+		}
+	}
+	return filtered
+}
+
+// runChecks runs the given checks concurrently and waits for all of them to complete.
+// The returned results are in the same order as the given checks.
+func (h *HealthCheck) runChecks(ctx context.Context, list []checks.Check) []namedResult {
 	type resultCollector struct {
-		name   string
+		index  int
 		result checks.Result
 	}
 
-	resultsChan := make(chan resultCollector, len(h.Checks))
+	resultsChan := make(chan resultCollector, len(list))
 
-	for _, check := range h.Checks {
-		go func(c checks.Check) {
-			result := c.Run(ctx)
+	for i, check := range list {
+		go func(i int, c checks.Check) {
 			resultsChan <- resultCollector{
-				name:   c.GetName(),
-				result: result,
+				index:  i,
+				result: h.runCheck(ctx, c),
 			}
-		}(check)
+		}(i, check)
 	}
 
-	// Collect results
+	results := make([]namedResult, len(list))
+	for range list {
+		cr := <-resultsChan
+		results[cr.index] = namedResult{
+			name:   list[cr.index].GetName(),
+			result: cr.result,
+		}
+	}
+
+	return results
+}
+
+// aggregate groups the results by check name and computes the overall status, applying the overrides
+// of the checks in place. If overall is set, the checks that WithCheck could not register fail the
+// overall status, and the override of the overall status is applied.
+func (h *HealthCheck) aggregate(list []namedResult, overall bool) CheckRunResult {
 	results := make(map[string][]checks.Result)
 	status := checks.StatusPass
 
-	for range h.Checks {
-		cr := <-resultsChan
-		results[cr.name] = append(results[cr.name], cr.result)
+	active := h.overrides.list(time.Now())
+	overrides := make(map[string]Override, len(active))
+	for _, override := range active {
+		overrides[override.Check] = override
+	}
+
+	for _, cr := range list {
+		result := cr.result
+		override, overridden := overrides[cr.name]
+		if overridden {
+			result = override.apply(result)
+		}
+		results[cr.name] = append(results[cr.name], result)
 
-		if cr.result.Status == checks.StatusFail {
+		if overridden && override.Exclude {
+			continue
+		}
+
+		checkStatus := h.impactOf(cr, result.Status)
+		if checkStatus == checks.StatusFail {
 			status = checks.StatusFail
-		} else if cr.result.Status == checks.StatusWarn && status != checks.StatusFail {
+		} else if checkStatus == checks.StatusWarn && status != checks.StatusFail {
 			status = checks.StatusWarn
 		}
 	}
 
+	if overall {
+		for _, rejected := range h.rejected {
+			results[rejected.name] = append(results[rejected.name], rejected.result())
+			status = checks.StatusFail
+		}
+	}
+
+	var applied []Override
+	for _, override := range active {
+		if _, ok := results[override.Check]; ok || (overall && override.IsOverall()) {
+			applied = append(applied, override)
+		}
+	}
+	if override, ok := overrides[""]; ok && overall {
+		status = override.Status
+	}
+
 	return CheckRunResult{
-		Status: status,
-		Checks: results,
+		Status:    status,
+		Checks:    results,
+		Overrides: applied,
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	healthcheck "github.com/brpaz/go-healthcheck/v2"
 	"github.com/brpaz/go-healthcheck/v2/checks"
@@ -75,10 +76,10 @@ func TestHealthCheck_AddCheck(t *testing.T) {
 	t.Parallel()
 
 	healthcheck := newHealthTest()
-	healthcheck.AddCheck(mockcheck.NewCheck(
+	require.NoError(t, healthcheck.AddCheck(mockcheck.NewCheck(
 		mockcheck.WithName("mockcheck-check"),
 		mockcheck.WithStatus(checks.StatusPass),
-	))
+	)))
 
 	assert.Len(t, healthcheck.Checks, 1)
 	assert.Equal(t, "mockcheck-check", healthcheck.Checks[0].GetName())
@@ -180,4 +181,62 @@ func TestHealthCheck_Execute(t *testing.T) {
 		assert.True(t, exists)
 		assert.Equal(t, checks.StatusWarn, warningCheckResult[0].Status)
 	})
+	t.Run("Warns With Failing Non-Critical Check", func(t *testing.T) {
+		t.Parallel()
+
+		cacheCheck := mockcheck.NewCheck(
+			mockcheck.WithName("cache-check"),
+			mockcheck.WithStatus(checks.StatusFail),
+		)
+		successCheck := mockcheck.NewCheck(
+			mockcheck.WithName("success-check"),
+			mockcheck.WithStatus(checks.StatusPass),
+		)
+
+		healthcheck := newHealthTest(
+			healthcheck.WithCheck(cacheCheck, healthcheck.WithCheckNonCritical()),
+			healthcheck.WithCheck(successCheck),
+		)
+
+		response := healthcheck.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, response.Status)
+		assert.Equal(t, checks.StatusFail, response.Checks["cache-check"][0].Status)
+	})
+
+	t.Run("Ignores Check With Pass Impact", func(t *testing.T) {
+		t.Parallel()
+
+		optionalCheck := mockcheck.NewCheck(
+			mockcheck.WithName("optional-check"),
+			mockcheck.WithStatus(checks.StatusFail),
+		)
+
+		hc := newHealthTest()
+		require.NoError(t, hc.AddCheck(optionalCheck, healthcheck.WithCheckImpact(checks.StatusPass)))
+
+		response := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusPass, response.Status)
+		assert.Equal(t, checks.StatusFail, response.Checks["optional-check"][0].Status)
+	})
+
+	t.Run("Fails With Failing Critical Check Next To Non-Critical Check", func(t *testing.T) {
+		t.Parallel()
+
+		healthcheck := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("cache-check"),
+				mockcheck.WithStatus(checks.StatusFail),
+			), healthcheck.WithCheckNonCritical()),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("db-check"),
+				mockcheck.WithStatus(checks.StatusFail),
+			)),
+		)
+
+		response := healthcheck.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, response.Status)
+	})
 }
@@ -0,0 +1,186 @@
+package healthcheck
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// WithHistorySize keeps the last size results of each check, and enables the statistics returned by History.
+// History is disabled by default.
+func WithHistorySize(size int) Option {
+	return func(h *HealthCheck) {
+		h.history.size = size
+	}
+}
+
+// CheckHistory holds the latest results of a check, oldest first, and the statistics computed from them.
+type CheckHistory struct {
+	Results []checks.Result `json:"results"`
+	Stats   CheckStats      `json:"stats"`
+}
+
+// CheckStats summarizes the history of a check.
+type CheckStats struct {
+	// Total is the number of results in the history.
+	Total int `json:"total"`
+	// SuccessRatio is the ratio of results with the pass status, between 0 and 1.
+	SuccessRatio float64 `json:"successRatio"`
+	// ObservedMean is the mean of the numeric observed values, or nil if there are none.
+	ObservedMean *float64 `json:"observedMean,omitempty"`
+	// ObservedP95 is the 95th percentile of the numeric observed values, or nil if there are none.
+	ObservedP95 *float64 `json:"observedP95,omitempty"`
+	// LastChange is the time of the last status change, or of the first result if the status never changed.
+	LastChange time.Time `json:"lastChange"`
+	// SinceLastChange is the time elapsed since LastChange.
+	SinceLastChange time.Duration `json:"-"`
+}
+
+// MarshalJSON encodes the statistics, with SinceLastChange as a human-readable duration.
+func (s CheckStats) MarshalJSON() ([]byte, error) {
+	type stats CheckStats
+	return json.Marshal(struct {
+		stats
+		SinceLastChange string `json:"sinceLastChange"`
+	}{
+		stats:           stats(s),
+		SinceLastChange: s.SinceLastChange.Round(time.Second).String(),
+	})
+}
+
+// History returns the history of the check with the given name.
+// It returns false if history is disabled or the check has not run yet.
+func (h *HealthCheck) History(name string) (CheckHistory, bool) {
+	return h.history.get(name, time.Now())
+}
+
+// historyOf returns the history of the checks with results in the given result.
+func (h *HealthCheck) historyOf(result CheckRunResult) map[string]CheckHistory {
+	histories := make(map[string]CheckHistory, len(result.Checks))
+	for name := range result.Checks {
+		if history, ok := h.History(name); ok {
+			histories[name] = history
+		}
+	}
+	return histories
+}
+
+// historyStore keeps a bounded history of results for each check.
+type historyStore struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*resultRing
+}
+
+// resultRing is a ring buffer of the latest results of a check.
+type resultRing struct {
+	results    []checks.Result
+	next       int
+	lastStatus checks.Status
+	lastChange time.Time
+}
+
+// add records the result in the history of the check, evicting the oldest result when the history is full.
+func (s *historyStore) add(name string, result checks.Result) {
+	if s.size <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]*resultRing)
+	}
+
+	ring, ok := s.entries[name]
+	if !ok {
+		ring = &resultRing{results: make([]checks.Result, 0, s.size)}
+		s.entries[name] = ring
+	}
+
+	if !ok || ring.lastStatus != result.Status {
+		ring.lastStatus = result.Status
+		ring.lastChange = result.Time
+		if ring.lastChange.IsZero() {
+			ring.lastChange = time.Now()
+		}
+	}
+
+	if len(ring.results) < s.size {
+		ring.results = append(ring.results, result)
+		return
+	}
+	ring.results[ring.next] = result
+	ring.next = (ring.next + 1) % s.size
+}
+
+// remove discards the history of the check with the given name.
+func (s *historyStore) remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, name)
+}
+
+// get returns the history of the check with the given name, with statistics computed at the given time.
+func (s *historyStore) get(name string, now time.Time) (CheckHistory, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ring, ok := s.entries[name]
+	if !ok {
+		return CheckHistory{}, false
+	}
+
+	results := make([]checks.Result, 0, len(ring.results))
+	results = append(results, ring.results[ring.next:]...)
+	results = append(results, ring.results[:ring.next]...)
+
+	stats := computeStats(results)
+	stats.LastChange = ring.lastChange
+	stats.SinceLastChange = now.Sub(ring.lastChange)
+
+	return CheckHistory{Results: results, Stats: stats}, true
+}
+
+// computeStats computes the success ratio and the observed value statistics of the results.
+func computeStats(results []checks.Result) CheckStats {
+	stats := CheckStats{Total: len(results)}
+
+	var passed int
+	var values []float64
+	for _, result := range results {
+		if result.Status == checks.StatusPass {
+			passed++
+		}
+		if value, ok := numericValue(result.ObservedValue); ok {
+			values = append(values, value)
+		}
+	}
+
+	if len(results) > 0 {
+		stats.SuccessRatio = float64(passed) / float64(len(results))
+	}
+
+	if len(values) > 0 {
+		var sum float64
+		for _, value := range values {
+			sum += value
+		}
+		mean := sum / float64(len(values))
+
+		// Nearest-rank percentile.
+		slices.Sort(values)
+		p95 := values[int(math.Ceil(0.95*float64(len(values))))-1]
+
+		stats.ObservedMean = &mean
+		stats.ObservedP95 = &p95
+	}
+
+	return stats
+}
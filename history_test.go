@@ -0,0 +1,146 @@
+package healthcheck_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_History(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Keeps The Last Results", func(t *testing.T) {
+		t.Parallel()
+
+		var results []checks.Result
+		for i := range 5 {
+			results = append(results, checks.Result{Status: checks.StatusPass, ObservedValue: i})
+		}
+		check := mockcheck.NewCheck(mockcheck.WithName("latency"), mockcheck.WithResults(results...))
+		hc := newHealthTest(
+			healthcheck.WithHistorySize(3),
+			healthcheck.WithCheck(check),
+		)
+
+		for range 5 {
+			hc.Execute(context.Background())
+		}
+
+		history, ok := hc.History("latency")
+		require.True(t, ok)
+		require.Len(t, history.Results, 3)
+		assert.Equal(t, 2, history.Results[0].ObservedValue)
+		assert.Equal(t, 3, history.Results[1].ObservedValue)
+		assert.Equal(t, 4, history.Results[2].ObservedValue)
+	})
+
+	t.Run("Computes Statistics", func(t *testing.T) {
+		t.Parallel()
+
+		lastChange := time.Now().Add(-time.Minute)
+		var results []checks.Result
+		for i := 1; i <= 20; i++ {
+			status := checks.StatusPass
+			if i%4 == 0 {
+				status = checks.StatusFail
+			}
+			results = append(results, checks.Result{Status: status, ObservedValue: float64(i), Time: lastChange})
+		}
+		check := mockcheck.NewCheck(mockcheck.WithName("latency"), mockcheck.WithResults(results...))
+		hc := newHealthTest(
+			healthcheck.WithHistorySize(20),
+			healthcheck.WithCheck(check),
+		)
+
+		for range 20 {
+			hc.Execute(context.Background())
+		}
+
+		history, ok := hc.History("latency")
+		require.True(t, ok)
+
+		stats := history.Stats
+		assert.Equal(t, 20, stats.Total)
+		assert.InDelta(t, 0.75, stats.SuccessRatio, 0.001)
+		require.NotNil(t, stats.ObservedMean)
+		assert.InDelta(t, 10.5, *stats.ObservedMean, 0.001)
+		require.NotNil(t, stats.ObservedP95)
+		assert.InDelta(t, 19, *stats.ObservedP95, 0.001)
+		assert.Equal(t, lastChange, stats.LastChange)
+		assert.GreaterOrEqual(t, stats.SinceLastChange, time.Minute)
+	})
+
+	t.Run("Omits Observed Statistics Without Numeric Values", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithHistorySize(10),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("api"),
+				mockcheck.WithResults(checks.Result{Status: checks.StatusPass, ObservedValue: "ok"}),
+			)),
+		)
+
+		hc.Execute(context.Background())
+
+		history, ok := hc.History("api")
+		require.True(t, ok)
+		assert.Nil(t, history.Stats.ObservedMean)
+		assert.Nil(t, history.Stats.ObservedP95)
+	})
+
+	t.Run("Is Disabled By Default", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(healthcheck.WithCheck(mockcheck.NewCheck(
+			mockcheck.WithName("api"),
+			mockcheck.WithStatus(checks.StatusPass),
+		)))
+
+		hc.Execute(context.Background())
+
+		_, ok := hc.History("api")
+		assert.False(t, ok)
+	})
+}
+
+func TestHandler_History(t *testing.T) {
+	t.Parallel()
+
+	hc := newHealthTest(
+		healthcheck.WithHistorySize(10),
+		healthcheck.WithCheck(mockcheck.NewCheck(
+			mockcheck.WithName("flaky"),
+			mockcheck.WithStatuses(checks.StatusFail, checks.StatusPass),
+		)),
+	)
+
+	for _, target := range []string{"/health", "/health?history=true"} {
+		rr := httptest.NewRecorder()
+		healthcheck.HealthHandler(hc).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+
+		var response healthcheck.HealthHttpResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+
+		if target == "/health" {
+			assert.Empty(t, response.History)
+			continue
+		}
+
+		require.Contains(t, response.History, "flaky")
+		assert.Len(t, response.History["flaky"].Results, 2)
+		assert.Equal(t, 2, response.History["flaky"].Stats.Total)
+		assert.InDelta(t, 0.5, response.History["flaky"].Stats.SuccessRatio, 0.001)
+		assert.Contains(t, rr.Body.String(), `"sinceLastChange":"0s"`)
+	}
+}
@@ -0,0 +1,141 @@
+package healthcheck
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// metricsContentType is the content type of the Prometheus text exposition format.
+const metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// MetricsHandler provides an HTTP handler that renders the latest check results in the
+// Prometheus text exposition format, with the following gauges:
+//   - healthcheck_overall_status: the overall status (pass=1, warn=0.5, fail=0).
+//   - healthcheck_status{check}: the status of each check (pass=1, warn=0.5, fail=0).
+//   - healthcheck_observed_value{check,unit}: the numeric observed value of each check, when available.
+//   - healthcheck_duration_seconds{check}: the time each check took to run.
+//
+// The "tag" and "check" query parameters select the checks as in HealthHandler, with a 404 response
+// if they match no check. When the background scheduler is running, the cached results are rendered
+// without running the checks.
+func MetricsHandler(healthchecker *HealthCheck) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result, ok := executeQuery(w, r, healthchecker)
+		if !ok {
+			return
+		}
+
+		w.Header().Set("Content-Type", metricsContentType)
+		w.WriteHeader(http.StatusOK)
+
+		_ = WriteMetrics(w, result)
+	}
+}
+
+// WriteMetrics writes the check run result to w in the Prometheus text exposition format.
+func WriteMetrics(w io.Writer, result CheckRunResult) error {
+	names := make([]string, 0, len(result.Checks))
+	for name, results := range result.Checks {
+		if len(results) > 0 {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var b strings.Builder
+
+	writeMetricHeader(&b, "healthcheck_overall_status", "Overall health status (pass=1, warn=0.5, fail=0).")
+	fmt.Fprintf(&b, "healthcheck_overall_status %s\n", formatFloat(statusValue(result.Status)))
+
+	writeMetricHeader(&b, "healthcheck_status", "Health status of the check (pass=1, warn=0.5, fail=0).")
+	for _, name := range names {
+		fmt.Fprintf(&b, "healthcheck_status{check=\"%s\"} %s\n",
+			escapeLabel(name), formatFloat(statusValue(result.Checks[name][0].Status)))
+	}
+
+	writeMetricHeader(&b, "healthcheck_observed_value", "Value observed by the check.")
+	for _, name := range names {
+		checkResult := result.Checks[name][0]
+		value, ok := numericValue(checkResult.ObservedValue)
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&b, "healthcheck_observed_value{check=\"%s\",unit=\"%s\"} %s\n",
+			escapeLabel(name), escapeLabel(checkResult.ObservedUnit), formatFloat(value))
+	}
+
+	writeMetricHeader(&b, "healthcheck_duration_seconds", "Time the check took to run, in seconds.")
+	for _, name := range names {
+		fmt.Fprintf(&b, "healthcheck_duration_seconds{check=\"%s\"} %s\n",
+			escapeLabel(name), formatFloat(result.Checks[name][0].Duration.Seconds()))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMetricHeader writes the HELP and TYPE lines of a gauge.
+func writeMetricHeader(b *strings.Builder, name, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s gauge\n", name)
+}
+
+// statusValue maps a status to its numeric metric value.
+func statusValue(status checks.Status) float64 {
+	switch status {
+	case checks.StatusPass:
+		return 1
+	case checks.StatusWarn:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// numericValue converts an observed value to a float64, if it is numeric.
+func numericValue(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// formatFloat formats a metric value.
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// escapeLabel escapes a label value as required by the text exposition format.
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
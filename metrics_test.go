@@ -0,0 +1,115 @@
+package healthcheck_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	hc := newHealthTest(
+		healthcheck.WithCheck(mockcheck.NewCheck(
+			mockcheck.WithName("disk"),
+			mockcheck.WithResults(checks.Result{
+				Status:        checks.StatusWarn,
+				ObservedValue: 85.5,
+				ObservedUnit:  checks.UnitPercent,
+				Duration:      1500 * time.Millisecond,
+			}),
+		)),
+		healthcheck.WithCheck(mockcheck.NewCheck(
+			mockcheck.WithName("database:ping"),
+			mockcheck.WithResults(checks.Result{
+				Status:        checks.StatusPass,
+				ObservedValue: int64(12),
+				ObservedUnit:  checks.UnitMilliseconds,
+				Duration:      12 * time.Millisecond,
+			}),
+		)),
+		healthcheck.WithCheck(mockcheck.NewCheck(
+			mockcheck.WithName(`quoted "check"`),
+			mockcheck.WithStatus(checks.StatusFail),
+		)),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rr := httptest.NewRecorder()
+	healthcheck.MetricsHandler(hc).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rr.Header().Get("Content-Type"))
+
+	body := rr.Body.String()
+	lines := strings.Split(body, "\n")
+
+	assert.Contains(t, lines, "# TYPE healthcheck_status gauge")
+	assert.Contains(t, lines, "healthcheck_overall_status 0")
+	assert.Contains(t, lines, `healthcheck_status{check="database:ping"} 1`)
+	assert.Contains(t, lines, `healthcheck_status{check="disk"} 0.5`)
+	assert.Contains(t, lines, `healthcheck_status{check="quoted \"check\""} 0`)
+	assert.Contains(t, lines, `healthcheck_observed_value{check="database:ping",unit="ms"} 12`)
+	assert.Contains(t, lines, `healthcheck_observed_value{check="disk",unit="%"} 85.5`)
+	assert.Contains(t, lines, `healthcheck_duration_seconds{check="disk"} 1.5`)
+	assert.Contains(t, lines, `healthcheck_duration_seconds{check="database:ping"} 0.012`)
+	assert.NotContains(t, body, `healthcheck_observed_value{check="quoted`)
+}
+
+func TestMetricsHandler_Filters(t *testing.T) {
+	t.Parallel()
+
+	hc := newHealthTest(
+		healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("db")), healthcheck.WithCheckTags("database")),
+		healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("cache"))),
+	)
+
+	t.Run("Renders Selected Checks", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodGet, "/metrics?check=db", nil)
+		rr := httptest.NewRecorder()
+		healthcheck.MetricsHandler(hc).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `healthcheck_status{check="db"} 1`)
+		assert.NotContains(t, rr.Body.String(), `check="cache"`)
+	})
+
+	t.Run("Returns Not Found For Unknown Filters", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			query     string
+			wantError string
+		}{
+			{query: "check=dbb", wantError: `check "dbb" not found`},
+			{query: "tag=database&check=cache", wantError: "no check matches the filter"},
+		}
+
+		for _, tt := range tests {
+			req := httptest.NewRequest(http.MethodGet, "/metrics?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			healthcheck.MetricsHandler(hc).ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusNotFound, rr.Code, tt.query)
+
+			var response struct {
+				Error       string   `json:"error"`
+				ValidChecks []string `json:"validChecks"`
+			}
+			assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantError, response.Error)
+			assert.Equal(t, []string{"cache", "db"}, response.ValidChecks)
+		}
+	})
+}
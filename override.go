@@ -0,0 +1,205 @@
+package healthcheck
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// ErrInvalidOverride is returned by SetOverride when the override is not valid.
+var ErrInvalidOverride = errors.New("healthcheck: invalid override")
+
+// Override forces the status of the service, or of a single check, regardless of the results of the checks.
+// It is typically used to put the service in maintenance mode during planned operations.
+type Override struct {
+	// Check is the name of the overridden check. It is empty for the overall status.
+	Check string `json:"check,omitempty"`
+	// Status is the status to report instead of the actual one.
+	Status checks.Status `json:"status,omitempty"`
+	// Exclude takes the check out of the evaluation of the overall status. It is only valid for checks.
+	Exclude bool `json:"exclude,omitempty"`
+	// Reason explains why the override is in place.
+	Reason string `json:"reason,omitempty"`
+	// ExpiresAt is the time after which the override no longer applies. The zero value never expires.
+	ExpiresAt time.Time `json:"expiresAt,omitzero"`
+}
+
+// IsOverall reports whether the override applies to the overall status.
+func (o Override) IsOverall() bool {
+	return o.Check == ""
+}
+
+// expired reports whether the override no longer applies at the given time.
+func (o Override) expired(now time.Time) bool {
+	return !o.ExpiresAt.IsZero() && !now.Before(o.ExpiresAt)
+}
+
+// describe returns a description of the override, prefixed to the output of the overridden result.
+func (o Override) describe() string {
+	var description string
+	if o.Exclude {
+		description = "excluded from evaluation"
+	} else {
+		description = "status overridden to " + string(o.Status)
+	}
+
+	if o.Reason != "" {
+		description += ": " + o.Reason
+	}
+	return description
+}
+
+// apply returns the result with the overridden status, keeping the actual status in the output.
+func (o Override) apply(result checks.Result) checks.Result {
+	output := fmt.Sprintf("%s (actual status %s", o.describe(), result.Status)
+	if result.Output != "" {
+		output += ": " + result.Output
+	}
+	result.Output = output + ")"
+
+	if !o.Exclude {
+		result.Status = o.Status
+	}
+	return result
+}
+
+// SetOverride forces the overall status, or the status of a single check, until it is cleared or expires.
+// It replaces any existing override for the same target. The override of the overall status applies to
+// Execute, to the health endpoint without filters and to the readiness probe.
+// It returns ErrUnknownCheck if the check is not registered, and ErrInvalidOverride if the override
+// neither sets a valid status nor excludes a check.
+func (h *HealthCheck) SetOverride(override Override) error {
+	if !override.IsOverall() && !h.HasCheck(override.Check) {
+		return fmt.Errorf("%w: %q", ErrUnknownCheck, override.Check)
+	}
+
+	switch {
+	case override.Exclude && override.IsOverall():
+		return fmt.Errorf("%w: the overall status cannot be excluded", ErrInvalidOverride)
+	case override.Exclude && override.Status != "":
+		return fmt.Errorf("%w: an excluded check cannot have a status", ErrInvalidOverride)
+	case !override.Exclude && !slices.Contains([]checks.Status{checks.StatusPass, checks.StatusWarn, checks.StatusFail}, override.Status):
+		return fmt.Errorf("%w: unsupported status %q", ErrInvalidOverride, override.Status)
+	}
+
+	h.overrides.mu.Lock()
+	defer h.overrides.mu.Unlock()
+
+	if h.overrides.active == nil {
+		h.overrides.active = make(map[string]Override)
+	}
+	h.overrides.active[override.Check] = override
+
+	return nil
+}
+
+// ClearOverride removes the override of the given check, or of the overall status if check is empty.
+func (h *HealthCheck) ClearOverride(check string) {
+	h.overrides.mu.Lock()
+	defer h.overrides.mu.Unlock()
+
+	delete(h.overrides.active, check)
+}
+
+// Overrides returns the overrides currently in place, sorted by check name, with the overall override first.
+func (h *HealthCheck) Overrides() []Override {
+	return h.overrides.list(time.Now())
+}
+
+// overrideStore holds the overrides set at runtime, keyed by check name.
+type overrideStore struct {
+	mu     sync.Mutex
+	active map[string]Override
+}
+
+// list returns the overrides that have not expired at the given time, dropping the expired ones.
+func (s *overrideStore) list(now time.Time) []Override {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	overrides := make([]Override, 0, len(s.active))
+	for name, override := range s.active {
+		if override.expired(now) {
+			delete(s.active, name)
+			continue
+		}
+		overrides = append(overrides, override)
+	}
+
+	slices.SortFunc(overrides, func(a, b Override) int {
+		return strings.Compare(a.Check, b.Check)
+	})
+	return overrides
+}
+
+// OverrideHandler provides an HTTP handler to manage overrides at runtime, authenticated with the given bearer token.
+// GET lists the active overrides, PUT or POST sets the Override in the JSON request body,
+// and DELETE clears the override of the check in the "check" query parameter, or of the overall status.
+// Requests without the token are rejected; an empty token rejects every request.
+func OverrideHandler(healthchecker *HealthCheck, token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r, token) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var override Override
+			if err := json.NewDecoder(r.Body).Decode(&override); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid override: "+err.Error())
+				return
+			}
+			if err := healthchecker.SetOverride(override); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		case http.MethodDelete:
+			healthchecker.ClearOverride(r.URL.Query().Get("check"))
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST, DELETE")
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(overridesResponse{Overrides: healthchecker.Overrides()})
+	}
+}
+
+// overridesResponse is the body returned by the override handler.
+type overridesResponse struct {
+	Overrides []Override `json:"overrides"`
+}
+
+// authorized reports whether the request carries the given bearer token, comparing it in constant time.
+func authorized(r *http.Request, token string) bool {
+	if token == "" {
+		return false
+	}
+
+	provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1
+}
+
+// writeError writes a JSON error response with the given status code.
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: message})
+}
+
+// errorResponse is the body of JSON error responses.
+type errorResponse struct {
+	Error string `json:"error"`
+}
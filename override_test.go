@@ -0,0 +1,220 @@
+package healthcheck_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func newOverrideTest() *healthcheck.HealthCheck {
+	return newHealthTest(
+		healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"), mockcheck.WithStatus(checks.StatusFail))),
+		healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("cache"))),
+	)
+}
+
+func TestHealthCheck_Overrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Forces Overall Status", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+		require.NoError(t, hc.SetOverride(healthcheck.Override{Status: checks.StatusWarn, Reason: "planned DB failover"}))
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["database"][0].Status)
+		require.Len(t, result.Overrides, 1)
+		assert.True(t, result.Overrides[0].IsOverall())
+	})
+
+	t.Run("Forces Overall Status Of Health And Readiness Only", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("database"), mockcheck.WithStatus(checks.StatusFail)),
+				healthcheck.WithCheckProbes(healthcheck.ProbeLiveness, healthcheck.ProbeReadiness),
+			),
+		)
+		require.NoError(t, hc.SetOverride(healthcheck.Override{Status: checks.StatusPass, Reason: "maintenance"}))
+
+		assert.Equal(t, checks.StatusPass, hc.ExecuteProbe(context.Background(), healthcheck.ProbeReadiness).Status)
+
+		liveness := hc.ExecuteProbe(context.Background(), healthcheck.ProbeLiveness)
+		assert.Equal(t, checks.StatusFail, liveness.Status)
+		assert.Empty(t, liveness.Overrides)
+
+		filtered := hc.ExecuteFiltered(context.Background(), healthcheck.Filter{Names: []string{"database"}})
+		assert.Equal(t, checks.StatusFail, filtered.Status)
+		assert.Empty(t, filtered.Overrides)
+	})
+
+	t.Run("Forces Check Status", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+		require.NoError(t, hc.SetOverride(healthcheck.Override{Check: "database", Status: checks.StatusWarn, Reason: "failover"}))
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, checks.StatusWarn, result.Checks["database"][0].Status)
+		assert.Equal(t, "status overridden to warn: failover (actual status fail: check failed)", result.Checks["database"][0].Output)
+	})
+
+	t.Run("Excludes Check From Evaluation", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+		require.NoError(t, hc.SetOverride(healthcheck.Override{Check: "database", Exclude: true, Reason: "failover"}))
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["database"][0].Status)
+		assert.Equal(t, "excluded from evaluation: failover (actual status fail: check failed)", result.Checks["database"][0].Output)
+	})
+
+	t.Run("Ignores Expired Overrides", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+		require.NoError(t, hc.SetOverride(healthcheck.Override{
+			Status:    checks.StatusPass,
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}))
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Empty(t, result.Overrides)
+		assert.Empty(t, hc.Overrides())
+	})
+
+	t.Run("Clears Override", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+		require.NoError(t, hc.SetOverride(healthcheck.Override{Check: "database", Exclude: true}))
+		hc.ClearOverride("database")
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Empty(t, hc.Overrides())
+	})
+
+	t.Run("Rejects Invalid Overrides", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+
+		assert.ErrorIs(t, hc.SetOverride(healthcheck.Override{Check: "unknown", Exclude: true}), healthcheck.ErrUnknownCheck)
+		assert.ErrorIs(t, hc.SetOverride(healthcheck.Override{Exclude: true}), healthcheck.ErrInvalidOverride)
+		assert.ErrorIs(t, hc.SetOverride(healthcheck.Override{Status: "unknown"}), healthcheck.ErrInvalidOverride)
+		assert.ErrorIs(t, hc.SetOverride(healthcheck.Override{Check: "database", Exclude: true, Status: checks.StatusPass}), healthcheck.ErrInvalidOverride)
+	})
+}
+
+func TestHandler_Overrides(t *testing.T) {
+	t.Parallel()
+
+	hc := newOverrideTest()
+	require.NoError(t, hc.SetOverride(healthcheck.Override{Status: checks.StatusWarn, Reason: "planned DB failover"}))
+
+	rr := httptest.NewRecorder()
+	healthcheck.HealthHandler(hc).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var response healthcheck.HealthHttpResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(t, checks.StatusWarn, response.Status)
+	assert.Equal(t, "status overridden to warn: planned DB failover; database: check failed", response.Output)
+	require.Len(t, response.Overrides, 1)
+	assert.Equal(t, "planned DB failover", response.Overrides[0].Reason)
+}
+
+func TestOverrideHandler(t *testing.T) {
+	t.Parallel()
+
+	const token = "s3cret"
+
+	serve := func(hc *healthcheck.HealthCheck, method, target, auth, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		rr := httptest.NewRecorder()
+		healthcheck.OverrideHandler(hc, token).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Rejects Unauthenticated Requests", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+
+		assert.Equal(t, http.StatusUnauthorized, serve(hc, http.MethodGet, "/admin/overrides", "", "").Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(hc, http.MethodGet, "/admin/overrides", "Bearer wrong", "").Code)
+
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/admin/overrides", nil)
+		req.Header.Set("Authorization", "Bearer ")
+		healthcheck.OverrideHandler(hc, "").ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Sets Lists And Clears Overrides", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+
+		rr := serve(hc, http.MethodPut, "/admin/overrides", "Bearer "+token, `{"check":"database","exclude":true,"reason":"failover"}`)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"overrides":[{"check":"database","exclude":true,"reason":"failover"}]}`, rr.Body.String())
+
+		rr = serve(hc, http.MethodGet, "/admin/overrides", "Bearer "+token, "")
+		assert.JSONEq(t, `{"overrides":[{"check":"database","exclude":true,"reason":"failover"}]}`, rr.Body.String())
+
+		rr = serve(hc, http.MethodDelete, "/admin/overrides?check=database", "Bearer "+token, "")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"overrides":[]}`, rr.Body.String())
+	})
+
+	t.Run("Rejects Invalid Overrides", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newOverrideTest()
+
+		rr := serve(hc, http.MethodPost, "/admin/overrides", "Bearer "+token, `{"check":"unknown","status":"warn"}`)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"healthcheck: unknown check: \"unknown\""}`, rr.Body.String())
+
+		rr = serve(hc, http.MethodPost, "/admin/overrides", "Bearer "+token, `not json`)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Rejects Unsupported Methods", func(t *testing.T) {
+		t.Parallel()
+
+		rr := serve(newOverrideTest(), http.MethodPatch, "/admin/overrides", "Bearer "+token, "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+		assert.Equal(t, "GET, PUT, POST, DELETE", rr.Header().Get("Allow"))
+	})
+}
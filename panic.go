@@ -0,0 +1,56 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// PanicHook is called when a check panics, with the name of the check,
+// the recovered value and the stack trace of the panic.
+type PanicHook func(name string, recovered any, stack []byte)
+
+// WithPanicStackTrace includes the stack trace in the output of checks that panic.
+func WithPanicStackTrace() Option {
+	return func(h *HealthCheck) {
+		h.panicStack = true
+	}
+}
+
+// WithPanicHook registers a hook that is called every time a check panics.
+func WithPanicHook(hook PanicHook) Option {
+	return func(h *HealthCheck) {
+		h.panicHook = hook
+	}
+}
+
+// runSafely runs the check, converting a panic into a StatusFail result.
+func (h *HealthCheck) runSafely(ctx context.Context, check checks.Check) (result checks.Result) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		stack := debug.Stack()
+		if h.panicHook != nil {
+			h.panicHook(check.GetName(), recovered, stack)
+		}
+
+		output := fmt.Sprintf("check panicked: %v", recovered)
+		if h.panicStack {
+			output += "\n" + string(stack)
+		}
+
+		result = checks.Result{
+			Status: checks.StatusFail,
+			Output: output,
+			Time:   time.Now(),
+		}
+	}()
+
+	return check.Run(ctx)
+}
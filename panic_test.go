@@ -0,0 +1,85 @@
+package healthcheck_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_Panics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Converts Panic Into Failed Result", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("panic-check"),
+				mockcheck.WithPanic("something went wrong"),
+			)),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("pass-check"))),
+		)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["panic-check"][0].Status)
+		assert.Equal(t, "check panicked: something went wrong", result.Checks["panic-check"][0].Output)
+		assert.Equal(t, checks.StatusPass, result.Checks["pass-check"][0].Status)
+	})
+
+	t.Run("Includes Stack Trace When Enabled", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithPanicStackTrace(),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("panic-check"),
+				mockcheck.WithPanic("something went wrong"),
+			)),
+		)
+
+		result := hc.Execute(context.Background())
+
+		output := result.Checks["panic-check"][0].Output
+		assert.Contains(t, output, "check panicked: something went wrong\n")
+		assert.Contains(t, output, "goroutine")
+	})
+
+	t.Run("Reports Panic To Hook", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mu        sync.Mutex
+			name      string
+			recovered any
+			stack     []byte
+		)
+
+		hc := newHealthTest(
+			healthcheck.WithPanicHook(func(n string, r any, s []byte) {
+				mu.Lock()
+				defer mu.Unlock()
+				name, recovered, stack = n, r, s
+			}),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("panic-check"),
+				mockcheck.WithPanic("something went wrong"),
+			)),
+		)
+
+		hc.Execute(context.Background())
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, "panic-check", name)
+		assert.Equal(t, "something went wrong", recovered)
+		assert.NotEmpty(t, stack)
+	})
+}
@@ -0,0 +1,54 @@
+package healthcheck
+
+import (
+	"context"
+	"slices"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// Probe identifies a group of checks that is served as a separate endpoint,
+// matching the Kubernetes liveness, readiness and startup probes.
+type Probe string
+
+const (
+	ProbeLiveness  Probe = "liveness"
+	ProbeReadiness Probe = "readiness"
+	ProbeStartup   Probe = "startup"
+)
+
+// WithCheckProbes registers the check in the given probe groups.
+// Checks without probes are only run by Execute and HealthHandler.
+func WithCheckProbes(probes ...Probe) CheckOption {
+	return func(c *checkConfig) {
+		c.probes = append(c.probes, probes...)
+	}
+}
+
+// ExecuteProbe runs the checks registered for the given probe and returns an aggregated result.
+// A probe without checks passes. The override of the overall status only applies to the readiness probe,
+// so that maintenance takes the service out of rotation without restarting it.
+// The startup probe latches: once it has passed, the passing result is returned without running the checks again.
+func (h *HealthCheck) ExecuteProbe(ctx context.Context, probe Probe) CheckRunResult {
+	if probe == ProbeStartup {
+		h.mu.Lock()
+		latched := h.startupResult
+		h.mu.Unlock()
+
+		if latched != nil {
+			return *latched
+		}
+	}
+
+	result := h.execute(ctx, func(check checks.Check) bool {
+		return slices.Contains(h.config(check).probes, probe)
+	}, probe == ProbeReadiness)
+
+	if probe == ProbeStartup && result.Status == checks.StatusPass {
+		h.mu.Lock()
+		h.startupResult = &result
+		h.mu.Unlock()
+	}
+
+	return result
+}
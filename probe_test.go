@@ -0,0 +1,112 @@
+package healthcheck_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_ExecuteProbe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Runs Only Checks Of The Probe", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("ping")),
+				healthcheck.WithCheckProbes(healthcheck.ProbeLiveness, healthcheck.ProbeReadiness),
+			),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("database"), mockcheck.WithStatus(checks.StatusFail)),
+				healthcheck.WithCheckProbes(healthcheck.ProbeReadiness),
+			),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("untagged"))),
+		)
+
+		liveness := hc.ExecuteProbe(context.Background(), healthcheck.ProbeLiveness)
+		assert.Equal(t, checks.StatusPass, liveness.Status)
+		assert.Len(t, liveness.Checks, 1)
+		assert.Contains(t, liveness.Checks, "ping")
+
+		readiness := hc.ExecuteProbe(context.Background(), healthcheck.ProbeReadiness)
+		assert.Equal(t, checks.StatusFail, readiness.Status)
+		assert.Len(t, readiness.Checks, 2)
+		assert.NotContains(t, readiness.Checks, "untagged")
+
+		all := hc.Execute(context.Background())
+		assert.Len(t, all.Checks, 3)
+	})
+
+	t.Run("Passes Probe Without Checks", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithStatus(checks.StatusFail))),
+		)
+
+		result := hc.ExecuteProbe(context.Background(), healthcheck.ProbeLiveness)
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Empty(t, result.Checks)
+	})
+
+	t.Run("Latches Startup Probe Once Passed", func(t *testing.T) {
+		t.Parallel()
+
+		check := mockcheck.NewCheck(
+			mockcheck.WithName("migrations"),
+			mockcheck.WithStatuses(checks.StatusFail, checks.StatusPass, checks.StatusFail),
+		)
+		hc := newHealthTest(
+			healthcheck.WithCheck(check, healthcheck.WithCheckProbes(healthcheck.ProbeStartup)),
+		)
+
+		assert.Equal(t, checks.StatusFail, hc.ExecuteProbe(context.Background(), healthcheck.ProbeStartup).Status)
+		assert.Equal(t, checks.StatusPass, hc.ExecuteProbe(context.Background(), healthcheck.ProbeStartup).Status)
+		assert.Equal(t, checks.StatusPass, hc.ExecuteProbe(context.Background(), healthcheck.ProbeStartup).Status)
+		assert.Equal(t, 2, check.Runs())
+	})
+}
+
+func TestProbeHandler(t *testing.T) {
+	t.Parallel()
+
+	hc := newHealthTest(
+		healthcheck.WithCheck(
+			mockcheck.NewCheck(mockcheck.WithName("ping")),
+			healthcheck.WithCheckProbes(healthcheck.ProbeLiveness),
+		),
+		healthcheck.WithCheck(
+			mockcheck.NewCheck(mockcheck.WithName("database"), mockcheck.WithStatus(checks.StatusFail)),
+			healthcheck.WithCheckProbes(healthcheck.ProbeReadiness),
+		),
+	)
+
+	t.Run("Liveness", func(t *testing.T) {
+		t.Parallel()
+
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/livez", nil)
+		healthcheck.ProbeHandler(hc, healthcheck.ProbeLiveness).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/health+json", rr.Header().Get("Content-Type"))
+	})
+
+	t.Run("Readiness", func(t *testing.T) {
+		t.Parallel()
+
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		healthcheck.ProbeHandler(hc, healthcheck.ProbeReadiness).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
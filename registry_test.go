@@ -0,0 +1,138 @@
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_Registry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Rejects Duplicate Names", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"))))
+
+		err := hc.AddCheck(mockcheck.NewCheck(mockcheck.WithName("database")))
+
+		assert.ErrorIs(t, err, healthcheck.ErrDuplicateCheck)
+		assert.Len(t, hc.GetChecks(), 1)
+	})
+
+	t.Run("Reports Duplicate Names Registered With Options", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"))),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"))),
+		)
+
+		assert.ErrorIs(t, hc.Err(), healthcheck.ErrDuplicateCheck)
+		assert.Len(t, hc.GetChecks(), 1)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		require.Len(t, result.Checks["database"], 2)
+		assert.Equal(t, checks.StatusPass, result.Checks["database"][0].Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["database"][1].Status)
+		assert.Equal(t, `not registered: healthcheck: duplicate check: "database"`, result.Checks["database"][1].Output)
+
+		filtered := hc.ExecuteFiltered(context.Background(), healthcheck.Filter{Names: []string{"database"}})
+		assert.Equal(t, checks.StatusPass, filtered.Status)
+	})
+
+	t.Run("Removes Check", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"), mockcheck.WithStatus(checks.StatusFail))),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("cache"))),
+		)
+
+		require.NoError(t, hc.RemoveCheck("database"))
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.NotContains(t, result.Checks, "database")
+		assert.False(t, hc.HasCheck("database"))
+		assert.ErrorIs(t, hc.RemoveCheck("database"), healthcheck.ErrUnknownCheck)
+	})
+
+	t.Run("Replaces Check In Place", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"), mockcheck.WithStatus(checks.StatusFail))),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("cache"))),
+		)
+
+		require.NoError(t, hc.ReplaceCheck(mockcheck.NewCheck(mockcheck.WithName("database")), healthcheck.WithCheckTags("db")))
+		result := hc.ExecuteFiltered(context.Background(), healthcheck.Filter{Tags: []string{"db"}})
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Contains(t, result.Checks, "database")
+		assert.Equal(t, "database", hc.GetChecks()[0].GetName())
+		assert.ErrorIs(t, hc.ReplaceCheck(mockcheck.NewCheck(mockcheck.WithName("unknown"))), healthcheck.ErrUnknownCheck)
+	})
+
+	t.Run("Updates Running Scheduler", func(t *testing.T) {
+		t.Parallel()
+
+		removed := mockcheck.NewCheck(mockcheck.WithName("removed"))
+		hc := newHealthTest(
+			healthcheck.WithInterval(10*time.Millisecond),
+			healthcheck.WithCheck(removed),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("replaced"))),
+		)
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		require.NoError(t, hc.RemoveCheck("removed"))
+		require.NoError(t, hc.ReplaceCheck(mockcheck.NewCheck(mockcheck.WithName("replaced"), mockcheck.WithStatus(checks.StatusWarn))))
+		runs := removed.Runs()
+
+		result := hc.Execute(context.Background())
+		assert.NotContains(t, result.Checks, "removed")
+		assert.Equal(t, checks.StatusWarn, result.Checks["replaced"][0].Status)
+
+		time.Sleep(50 * time.Millisecond)
+		assert.LessOrEqual(t, removed.Runs(), runs+1)
+	})
+
+	t.Run("Supports Concurrent Registration", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest()
+
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				name := fmt.Sprintf("check-%d", i)
+				assert.NoError(t, hc.AddCheck(mockcheck.NewCheck(mockcheck.WithName(name))))
+				if i%2 == 0 {
+					assert.NoError(t, hc.RemoveCheck(name))
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				hc.Execute(context.Background())
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, hc.CheckNames(), 10)
+	})
+}
@@ -0,0 +1,304 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+const defaultInterval = 30 * time.Second
+
+// ErrSchedulerRunning is returned by Start when the background scheduler is already running.
+var ErrSchedulerRunning = errors.New("healthcheck: scheduler already running")
+
+// WithInterval sets the default interval at which the background scheduler runs each check (default: 30s).
+// It can be overridden per check with WithCheckInterval.
+func WithInterval(interval time.Duration) Option {
+	return func(h *HealthCheck) {
+		h.interval = interval
+	}
+}
+
+// scheduler keeps the state of the background execution of checks.
+type scheduler struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	hc      *HealthCheck
+	wg      sync.WaitGroup
+	mu      sync.RWMutex
+	entries []*scheduledCheck
+}
+
+// scheduledCheck holds the latest result of a check run by the scheduler.
+type scheduledCheck struct {
+	check  checks.Check
+	ctx    context.Context
+	cancel context.CancelFunc
+	result checks.Result
+	impact checks.Status
+	ready  bool
+}
+
+// named returns the latest result of the entry, with the name of its check.
+func (e *scheduledCheck) named() namedResult {
+	return namedResult{name: e.check.GetName(), result: e.result, impact: e.impact}
+}
+
+// Start runs every registered check once and then keeps running each check in a background
+// goroutine at its configured interval, until ctx is cancelled or Stop is called.
+// While the scheduler is running, Execute serves the latest cached results instead of running the checks.
+// Checks can be executed, added and removed, and the scheduler stopped, while the first run is in progress.
+func (h *HealthCheck) Start(ctx context.Context) error {
+	h.mu.Lock()
+	if h.scheduler != nil && h.scheduler.ctx.Err() == nil {
+		h.mu.Unlock()
+		return ErrSchedulerRunning
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	s := &scheduler{
+		ctx:    runCtx,
+		cancel: cancel,
+		hc:     h,
+	}
+
+	// Install the scheduler before the first run, so that checks added or removed meanwhile update its entries.
+	// Until the first run completes, the entries are not ready and are executed inline by Execute.
+	list := h.GetChecks()
+	initial := make([]*scheduledCheck, len(list))
+	for i, check := range list {
+		initial[i] = s.newEntry(check)
+	}
+	s.entries = slices.Clone(initial)
+	h.scheduler = s
+	h.mu.Unlock()
+
+	initCtx, initCancel := h.withDeadline(runCtx)
+	defer initCancel()
+
+	results := h.runOrdered(initCtx, list)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Stop was called, or ctx was cancelled, during the first run.
+	if h.scheduler != s || runCtx.Err() != nil {
+		return nil
+	}
+
+	// Store every result before starting the goroutines, which read the entries when they run.
+	// Entries removed or replaced during the first run have been cancelled, and are not started.
+	var started []*scheduledCheck
+	s.mu.Lock()
+	for i, entry := range initial {
+		if entry.ctx.Err() != nil {
+			continue
+		}
+		entry.result = results[i].result
+		entry.impact = results[i].impact
+		entry.ready = true
+		started = append(started, entry)
+	}
+	s.mu.Unlock()
+
+	for _, entry := range started {
+		s.start(entry, h.intervalFor(entry.check), false)
+	}
+
+	return nil
+}
+
+// Stop stops the background scheduler and waits for the running checks to finish.
+// After Stop, Execute runs the checks on every call again.
+func (h *HealthCheck) Stop() {
+	h.mu.Lock()
+	s := h.scheduler
+	h.scheduler = nil
+	h.mu.Unlock()
+
+	if s == nil {
+		return
+	}
+
+	s.cancel()
+	s.wg.Wait()
+}
+
+// intervalFor returns the scheduling interval of the given check.
+func (h *HealthCheck) intervalFor(check checks.Check) time.Duration {
+	if interval := h.config(check).interval; interval > 0 {
+		return interval
+	}
+	if h.interval > 0 {
+		return h.interval
+	}
+	return defaultInterval
+}
+
+// scheduleCheck adds the check to the running scheduler, if any, unless it is already scheduled.
+func (h *HealthCheck) scheduleCheck(check checks.Check) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s := h.scheduler
+	if s == nil || s.ctx.Err() != nil {
+		return
+	}
+
+	s.mu.Lock()
+	if slices.ContainsFunc(s.entries, func(e *scheduledCheck) bool {
+		return e.check.GetName() == check.GetName()
+	}) {
+		s.mu.Unlock()
+		return
+	}
+	entry := s.newEntry(check)
+	s.entries = append(s.entries, entry)
+	s.mu.Unlock()
+
+	s.start(entry, h.intervalFor(check), true)
+}
+
+// unscheduleCheck removes the check with the given name from the running scheduler, if any,
+// cancelling its ongoing run.
+func (h *HealthCheck) unscheduleCheck(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s := h.scheduler
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = slices.DeleteFunc(s.entries, func(entry *scheduledCheck) bool {
+		if entry.check.GetName() != name {
+			return false
+		}
+		entry.cancel()
+		return true
+	})
+}
+
+// snapshot returns the cached results of the running scheduler for the checks accepted by include.
+// Checks that have not completed their first run yet are executed inline.
+func (h *HealthCheck) snapshot(ctx context.Context, include func(checks.Check) bool) ([]namedResult, bool) {
+	h.mu.Lock()
+	s := h.scheduler
+	h.mu.Unlock()
+
+	if s == nil || s.ctx.Err() != nil {
+		return nil, false
+	}
+
+	s.mu.RLock()
+	results := make([]namedResult, 0, len(s.entries))
+	var pending []checks.Check
+	for _, entry := range s.entries {
+		if include != nil && !include(entry.check) {
+			continue
+		}
+		if !entry.ready {
+			pending = append(pending, entry.check)
+			continue
+		}
+		results = append(results, entry.named())
+	}
+	s.mu.RUnlock()
+
+	return append(results, h.runChecks(ctx, pending)...), true
+}
+
+// latest returns the latest results of the checks with the given name.
+func (s *scheduler) latest(name string) []namedResult {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []namedResult
+	for _, entry := range s.entries {
+		if entry.ready && entry.check.GetName() == name {
+			results = append(results, entry.named())
+		}
+	}
+	return results
+}
+
+// runScheduled runs a check on behalf of the scheduler, enforcing the global and per-check timeouts.
+func (h *HealthCheck) runScheduled(ctx context.Context, check checks.Check) checks.Result {
+	ctx, cancel := h.withDeadline(ctx)
+	defer cancel()
+
+	return h.runCheck(ctx, check)
+}
+
+// newEntry returns an entry for the check, not ready until its first run, that is cancelled when
+// the scheduler stops or the check is unscheduled.
+func (s *scheduler) newEntry(check checks.Check) *scheduledCheck {
+	entry := &scheduledCheck{check: check}
+	entry.ctx, entry.cancel = context.WithCancel(s.ctx)
+	return entry
+}
+
+// start launches the goroutine that runs the check at every interval, until the scheduler stops
+// or the check is unscheduled. When immediate is true, the check runs once before waiting for the first tick.
+func (s *scheduler) start(entry *scheduledCheck, interval time.Duration, immediate bool) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer entry.cancel()
+
+		if immediate {
+			s.runEntry(entry)
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-entry.ctx.Done():
+				return
+			case <-ticker.C:
+				s.runEntry(entry)
+			}
+		}
+	}()
+}
+
+// runEntry executes the check and stores its result.
+// The check is skipped if the latest result of one of its dependencies failed.
+func (s *scheduler) runEntry(entry *scheduledCheck) {
+	var result checks.Result
+	var impact checks.Status
+	if skip, skipped := s.hc.skipResult(entry.check, s.latest); skipped {
+		result = s.hc.record(entry.check, skip.result)
+		impact = skip.impact
+	} else {
+		result = s.hc.runScheduled(entry.ctx, entry.check)
+	}
+
+	s.mu.Lock()
+	if entry.ctx.Err() != nil {
+		s.mu.Unlock()
+		return
+	}
+	entry.result = result
+	entry.impact = impact
+	entry.ready = true
+
+	results := make([]namedResult, 0, len(s.entries))
+	for _, e := range s.entries {
+		if e.ready {
+			results = append(results, e.named())
+		}
+	}
+	s.mu.Unlock()
+
+	s.hc.observeOverall(s.hc.aggregate(results, true))
+}
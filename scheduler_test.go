@@ -0,0 +1,209 @@
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_Start(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Serves Cached Results", func(t *testing.T) {
+		t.Parallel()
+
+		check := mockcheck.NewCheck(mockcheck.WithName("counting-check"), mockcheck.WithStatus(checks.StatusPass))
+		hc := newHealthTest(
+			healthcheck.WithInterval(time.Hour),
+			healthcheck.WithCheck(check),
+		)
+
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		assert.Equal(t, 1, check.Runs())
+
+		for range 5 {
+			result := hc.Execute(context.Background())
+			assert.Equal(t, checks.StatusPass, result.Status)
+			assert.Len(t, result.Checks["counting-check"], 1)
+		}
+
+		assert.Equal(t, 1, check.Runs())
+	})
+
+	t.Run("Runs Checks At Their Interval", func(t *testing.T) {
+		t.Parallel()
+
+		fast := mockcheck.NewCheck(mockcheck.WithName("fast-check"), mockcheck.WithStatus(checks.StatusPass))
+		slow := mockcheck.NewCheck(mockcheck.WithName("slow-check"), mockcheck.WithStatus(checks.StatusPass))
+		hc := newHealthTest(
+			healthcheck.WithInterval(time.Hour),
+			healthcheck.WithCheck(fast, healthcheck.WithCheckInterval(10*time.Millisecond)),
+			healthcheck.WithCheck(slow),
+		)
+
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		assert.Eventually(t, func() bool {
+			return fast.Runs() >= 3
+		}, time.Second, 5*time.Millisecond)
+		assert.Equal(t, 1, slow.Runs())
+	})
+
+	t.Run("Schedules Checks Added After Start", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(healthcheck.WithInterval(time.Hour))
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		check := mockcheck.NewCheck(mockcheck.WithName("late-check"), mockcheck.WithStatus(checks.StatusWarn))
+		require.NoError(t, hc.AddCheck(check))
+
+		result := hc.Execute(context.Background())
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Len(t, result.Checks["late-check"], 1)
+	})
+
+	t.Run("Fails When Already Running", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest()
+		require.NoError(t, hc.Start(context.Background()))
+		defer hc.Stop()
+
+		assert.ErrorIs(t, hc.Start(context.Background()), healthcheck.ErrSchedulerRunning)
+	})
+
+	t.Run("Runs Checks On Every Call After Stop", func(t *testing.T) {
+		t.Parallel()
+
+		check := mockcheck.NewCheck(mockcheck.WithName("counting-check"), mockcheck.WithStatus(checks.StatusPass))
+		hc := newHealthTest(
+			healthcheck.WithInterval(time.Hour),
+			healthcheck.WithCheck(check),
+		)
+
+		require.NoError(t, hc.Start(context.Background()))
+		hc.Stop()
+
+		hc.Execute(context.Background())
+		hc.Execute(context.Background())
+
+		assert.Equal(t, 3, check.Runs())
+	})
+
+	t.Run("Stops When Context Is Cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		check := mockcheck.NewCheck(mockcheck.WithName("counting-check"), mockcheck.WithStatus(checks.StatusPass))
+		hc := newHealthTest(
+			healthcheck.WithInterval(time.Hour),
+			healthcheck.WithCheck(check),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		require.NoError(t, hc.Start(ctx))
+		cancel()
+
+		hc.Execute(context.Background())
+		assert.Equal(t, 2, check.Runs())
+		assert.NoError(t, hc.Start(context.Background()))
+		hc.Stop()
+	})
+
+	t.Run("Does Not Block While Running Checks For The First Time", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan struct{})
+		slow := mockcheck.NewCheck(
+			mockcheck.WithName("slow-check"),
+			mockcheck.WithHook(func(ctx context.Context) {
+				select {
+				case <-release:
+				case <-ctx.Done():
+				}
+			}),
+		)
+		hc := newHealthTest(
+			healthcheck.WithInterval(time.Hour),
+			healthcheck.WithCheck(slow),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("fast-check"))),
+		)
+
+		started := make(chan error, 1)
+		go func() {
+			started <- hc.Start(context.Background())
+		}()
+		require.Eventually(t, func() bool {
+			return slow.Runs() == 1
+		}, time.Second, time.Millisecond)
+
+		result := hc.ExecuteFiltered(context.Background(), healthcheck.Filter{Names: []string{"fast-check"}})
+		assert.Equal(t, checks.StatusPass, result.Status)
+		require.NoError(t, hc.AddCheck(mockcheck.NewCheck(mockcheck.WithName("late-check"))))
+
+		close(release)
+		require.NoError(t, <-started)
+		defer hc.Stop()
+
+		assert.Len(t, hc.Execute(context.Background()).Checks, 3)
+		assert.Equal(t, 1, slow.Runs())
+	})
+
+	t.Run("Stops While Running Checks For The First Time", func(t *testing.T) {
+		t.Parallel()
+
+		check := mockcheck.NewCheck(
+			mockcheck.WithName("slow-check"),
+			mockcheck.WithHook(func(ctx context.Context) {
+				<-ctx.Done()
+			}),
+		)
+		hc := newHealthTest(healthcheck.WithCheck(check))
+
+		started := make(chan error, 1)
+		go func() {
+			started <- hc.Start(context.Background())
+		}()
+		require.Eventually(t, func() bool {
+			return check.Runs() == 1
+		}, time.Second, time.Millisecond)
+
+		hc.Stop()
+
+		select {
+		case err := <-started:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("Start did not return after Stop")
+		}
+	})
+
+	t.Run("Starts Many Fast Checks", func(t *testing.T) {
+		t.Parallel()
+
+		var opts []healthcheck.Option
+		for i := range 50 {
+			check := mockcheck.NewCheck(mockcheck.WithName(fmt.Sprintf("check-%d", i)))
+			opts = append(opts, healthcheck.WithCheck(check, healthcheck.WithCheckInterval(time.Microsecond)))
+		}
+		hc := newHealthTest(opts...)
+
+		require.NoError(t, hc.Start(context.Background()))
+		time.Sleep(10 * time.Millisecond)
+		hc.Stop()
+
+		assert.Len(t, hc.Execute(context.Background()).Checks, 50)
+	})
+}
@@ -0,0 +1,107 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// WithTimeout sets a global deadline for running the checks. Checks that are still running
+// when the deadline is reached are reported with the timeout status.
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *HealthCheck) {
+		h.timeout = timeout
+	}
+}
+
+// WithTimeoutStatus sets the status reported for checks that overrun their timeout (default: StatusFail).
+func WithTimeoutStatus(status checks.Status) Option {
+	return func(h *HealthCheck) {
+		h.timeoutStatus = status
+	}
+}
+
+// withDeadline applies the global timeout to the given context, if one is configured.
+func (h *HealthCheck) withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if h.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, h.timeout)
+}
+
+// runCheck runs a single check, enforcing its timeout.
+// A check that does not return before its context is done is reported with a synthesized result,
+// and its late result is discarded.
+func (h *HealthCheck) runCheck(ctx context.Context, check checks.Check) checks.Result {
+	result := h.runLimited(ctx, check, h.config(check).timeout)
+	return h.record(check, result)
+}
+
+// record fills the configured component fields of the result, tracks its status and adds it to the history.
+func (h *HealthCheck) record(check checks.Check, result checks.Result) checks.Result {
+	result = h.config(check).component.describe(result)
+	h.tracker.observe(check.GetName(), result)
+	h.history.add(check.GetName(), result)
+
+	return result
+}
+
+// runWithTimeout runs the check, bounding its execution to the given timeout, if any.
+// The done function is called once the check returns, which can be after the timeout.
+func (h *HealthCheck) runWithTimeout(ctx context.Context, check checks.Check, timeout time.Duration, done func()) checks.Result {
+	checkCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	results := make(chan checks.Result, 1)
+	go func() {
+		defer done()
+		results <- h.runSafely(checkCtx, check)
+	}()
+
+	var result checks.Result
+	select {
+	case result = <-results:
+	case <-checkCtx.Done():
+		result = h.timeoutResult(ctx, checkCtx, timeout, time.Since(start))
+	}
+
+	if result.Duration == 0 {
+		result.Duration = time.Since(start)
+	}
+
+	return result
+}
+
+// timeoutResult builds the result reported for a check that did not complete in time.
+func (h *HealthCheck) timeoutResult(parent, checkCtx context.Context, timeout, elapsed time.Duration) checks.Result {
+	status := h.timeoutStatus
+	if status == "" {
+		status = checks.StatusFail
+	}
+
+	var output string
+	switch {
+	case parent.Err() == nil && timeout > 0:
+		output = fmt.Sprintf("timed out after %s", timeout)
+	case errors.Is(checkCtx.Err(), context.DeadlineExceeded) && h.timeout > 0:
+		output = fmt.Sprintf("timed out after %s", h.timeout)
+	case errors.Is(checkCtx.Err(), context.DeadlineExceeded):
+		output = fmt.Sprintf("timed out after %s", elapsed.Round(time.Millisecond))
+	default:
+		output = "check cancelled: " + checkCtx.Err().Error()
+	}
+
+	return checks.Result{
+		Status: status,
+		Output: output,
+		Time:   time.Now(),
+	}
+}
@@ -0,0 +1,90 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+)
+
+func TestHealthCheck_Timeouts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Fails Check That Overruns Global Timeout", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithTimeout(50*time.Millisecond),
+			healthcheck.WithCheck(mockcheck.NewCheck(
+				mockcheck.WithName("slow-check"),
+				mockcheck.WithDelay(time.Second),
+			)),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("fast-check"))),
+		)
+
+		start := time.Now()
+		result := hc.Execute(context.Background())
+
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, checks.StatusFail, result.Checks["slow-check"][0].Status)
+		assert.Equal(t, "timed out after 50ms", result.Checks["slow-check"][0].Output)
+		assert.Equal(t, checks.StatusPass, result.Checks["fast-check"][0].Status)
+	})
+
+	t.Run("Fails Check That Overruns Its Own Timeout", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithTimeout(time.Second),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("slow-check"), mockcheck.WithDelay(time.Second)),
+				healthcheck.WithCheckTimeout(20*time.Millisecond),
+			),
+		)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusFail, result.Status)
+		assert.Equal(t, "timed out after 20ms", result.Checks["slow-check"][0].Output)
+	})
+
+	t.Run("Uses Configured Timeout Status", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithTimeoutStatus(checks.StatusWarn),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("slow-check"), mockcheck.WithDelay(time.Second)),
+				healthcheck.WithCheckTimeout(20*time.Millisecond),
+			),
+		)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusWarn, result.Status)
+		assert.Equal(t, checks.StatusWarn, result.Checks["slow-check"][0].Status)
+	})
+
+	t.Run("Passes Check That Completes In Time", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithTimeout(time.Second),
+			healthcheck.WithCheck(
+				mockcheck.NewCheck(mockcheck.WithName("slow-check"), mockcheck.WithDelay(10*time.Millisecond)),
+				healthcheck.WithCheckTimeout(500*time.Millisecond),
+			),
+		)
+
+		result := hc.Execute(context.Background())
+
+		assert.Equal(t, checks.StatusPass, result.Status)
+		assert.Empty(t, result.Checks["slow-check"][0].Output)
+	})
+}
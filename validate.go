@@ -0,0 +1,39 @@
+package healthcheck
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brpaz/go-healthcheck/v2/checks"
+)
+
+// ErrInvalidCheck is returned by Validate for checks with an invalid configuration.
+var ErrInvalidCheck = errors.New("healthcheck: invalid check")
+
+// Validate verifies the configuration of the HealthCheck without running any check, so that services
+// can refuse to start with a bad health configuration. It reports the errors that occurred while
+// registering checks with WithCheck, the configuration errors of the checks that implement
+// checks.Validator, and the dependencies that are not registered.
+func (h *HealthCheck) Validate() error {
+	errs := []error{h.Err()}
+
+	h.checksMu.RLock()
+	defer h.checksMu.RUnlock()
+
+	for _, check := range h.Checks {
+		name := check.GetName()
+		if err := checks.Validate(check); err != nil {
+			errs = append(errs, fmt.Errorf("%w %q: %w", ErrInvalidCheck, name, err))
+		}
+
+		if cfg, ok := h.checkConfigs[name]; ok {
+			for _, dep := range cfg.dependsOn {
+				if h.indexOf(dep) < 0 {
+					errs = append(errs, fmt.Errorf("%w: %q, dependency of %q", ErrUnknownCheck, dep, name))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,69 @@
+package healthcheck_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	healthcheck "github.com/brpaz/go-healthcheck/v2"
+	"github.com/brpaz/go-healthcheck/v2/checks/diskcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/mockcheck"
+	"github.com/brpaz/go-healthcheck/v2/checks/tcpcheck"
+)
+
+func TestHealthCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Accepts Valid Configuration", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(tcpcheck.NewCheck(tcpcheck.WithHost("db"), tcpcheck.WithPort(5432))),
+			healthcheck.WithCheck(diskcheck.NewCheck(), healthcheck.WithCheckDependsOn("tcp-check")),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("cache"))),
+		)
+
+		assert.NoError(t, hc.Validate())
+	})
+
+	t.Run("Reports Invalid Checks", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(tcpcheck.NewCheck(tcpcheck.WithName("tcp:postgres"), tcpcheck.WithHost("db"))),
+			healthcheck.WithCheck(diskcheck.NewCheck(diskcheck.WithWarnThreshold(95))),
+		)
+
+		err := hc.Validate()
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, healthcheck.ErrInvalidCheck)
+		assert.Contains(t, err.Error(), `healthcheck: invalid check "tcp:postgres": port must be between 1 and 65535, got 0`)
+		assert.Contains(t, err.Error(), `healthcheck: invalid check "disk-check": warn threshold 95.0 is above fail threshold 90.0`)
+	})
+
+	t.Run("Reports Unknown Dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("api")), healthcheck.WithCheckDependsOn("databse")),
+		)
+
+		err := hc.Validate()
+
+		assert.ErrorIs(t, err, healthcheck.ErrUnknownCheck)
+		assert.EqualError(t, err, `healthcheck: unknown check: "databse", dependency of "api"`)
+	})
+
+	t.Run("Reports Registration Errors", func(t *testing.T) {
+		t.Parallel()
+
+		hc := newHealthTest(
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"))),
+			healthcheck.WithCheck(mockcheck.NewCheck(mockcheck.WithName("database"))),
+		)
+
+		assert.ErrorIs(t, hc.Validate(), healthcheck.ErrDuplicateCheck)
+	})
+}